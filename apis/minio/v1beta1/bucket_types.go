@@ -83,6 +83,48 @@ type BucketParameters struct {
 	// this set. When omitted (nil), bucket tags are not managed by this resource.
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
+
+	// LifecycleRules is the list of lifecycle rules of the bucket.
+	// When set (including to an empty list), the bucket lifecycle configuration is reconciled to exactly
+	// these rules. When omitted, the lifecycle configuration is not managed by this resource.
+	// +optional
+	LifecycleRules *[]BucketLifecycleRule `json:"lifecycleRules,omitempty"`
+}
+
+// BucketLifecycleRule defines a lifecycle rule of a bucket.
+type BucketLifecycleRule struct {
+	// ID identifies the rule within the bucket.
+	// +kubebuilder:validation:Required
+	ID string `json:"id"`
+
+	// Prefix limits the rule to objects whose key starts with the prefix.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// Disabled keeps the rule in the lifecycle configuration without applying it.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Transition moves objects to a remote tier.
+	// +optional
+	Transition *BucketLifecycleTransition `json:"transition,omitempty"`
+
+	// ExpirationDays deletes objects the given number of days after their creation.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ExpirationDays int `json:"expirationDays,omitempty"`
+}
+
+// BucketLifecycleTransition defines when objects are moved to a remote tier.
+type BucketLifecycleTransition struct {
+	// Days is the number of days after their creation objects are moved to the tier.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	Days int `json:"days"`
+
+	// TierName is the name of the tier on the server, as reported by `status.atProvider.tierName` of a Tier.
+	// +kubebuilder:validation:Required
+	TierName string `json:"tierName"`
 }
 
 // BucketProviderStatus defines the observed state of a Bucket from the provider
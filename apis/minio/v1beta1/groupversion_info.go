@@ -37,6 +37,20 @@ func addKnownTypes(s *runtime.Scheme) error {
 		&ServiceAccountList{},
 		&NotificationConfiguration{},
 		&NotificationConfigurationList{},
+		&NotificationTarget{},
+		&NotificationTargetList{},
+		&Tier{},
+		&TierList{},
+		&ServerConfig{},
+		&ServerConfigList{},
+		&LogTarget{},
+		&LogTargetList{},
+		&OpenIDProvider{},
+		&OpenIDProviderList{},
+		&LDAPProvider{},
+		&LDAPProviderList{},
+		&LDAPPolicyMapping{},
+		&LDAPPolicyMappingList{},
 		&Policy{},
 		&PolicyList{},
 	)
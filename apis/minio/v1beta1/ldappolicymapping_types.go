@@ -0,0 +1,70 @@
+package v1beta1
+
+import (
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="User",type="string",JSONPath=".spec.forProvider.user"
+// +kubebuilder:printcolumn:name="Group",type="string",JSONPath=".spec.forProvider.group"
+// +kubebuilder:printcolumn:name="Policies",type="string",JSONPath=".status.atProvider.policies"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,minio}
+// +kubebuilder:webhook:verbs=create;update,path=/validate-minio-m-crossplane-io-v1beta1-ldappolicymapping,mutating=false,failurePolicy=fail,groups=minio.m.crossplane.io,resources=ldappolicymappings,versions=v1beta1,name=ldappolicymappings.minio.m.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// LDAPPolicyMapping is a namespaced managed resource that represents the policies
+// attached to an LDAP user or group DN on the MinIO server.
+type LDAPPolicyMapping struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LDAPPolicyMappingSpec   `json:"spec"`
+	Status LDAPPolicyMappingStatus `json:"status,omitempty"`
+}
+
+// LDAPPolicyMappingSpec defines the desired state of an LDAPPolicyMapping
+type LDAPPolicyMappingSpec struct {
+	xpv1.ManagedResourceSpec `json:",inline"`
+	ForProvider              LDAPPolicyMappingParameters `json:"forProvider,omitempty"`
+}
+
+// LDAPPolicyMappingStatus defines the observed state of an LDAPPolicyMapping
+type LDAPPolicyMappingStatus struct {
+	xpv1.ConditionedStatus `json:",inline"`
+	AtProvider             LDAPPolicyMappingProviderStatus `json:"atProvider,omitempty"`
+}
+
+// LDAPPolicyMappingParameters define the policies of an LDAP user or group
+type LDAPPolicyMappingParameters struct {
+	// User is the DN of the LDAP user the policies are attached to.
+	// Exactly one of User and Group must be set. Cannot be changed after the resource is created.
+	User string `json:"user,omitempty"`
+
+	// Group is the DN of the LDAP group the policies are attached to.
+	// Exactly one of User and Group must be set. Cannot be changed after the resource is created.
+	Group string `json:"group,omitempty"`
+
+	// Policies are the names of the policies attached to the user or group.
+	// Policies attached to the DN that are not listed are detached.
+	// +kubebuilder:validation:MinItems=1
+	Policies []string `json:"policies"`
+}
+
+// LDAPPolicyMappingProviderStatus defines the observed state of an LDAPPolicyMapping from the provider
+type LDAPPolicyMappingProviderStatus struct {
+	// Policies are the names of the policies attached to the user or group on the server.
+	Policies []string `json:"policies,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LDAPPolicyMappingList contains a list of LDAPPolicyMapping resources
+type LDAPPolicyMappingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LDAPPolicyMapping `json:"items"`
+}
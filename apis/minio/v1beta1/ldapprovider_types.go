@@ -0,0 +1,107 @@
+package v1beta1
+
+import (
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	"github.com/rossigee/provider-minio/apis/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="Server",type="string",JSONPath=".spec.forProvider.serverAddress"
+// +kubebuilder:printcolumn:name="Reachable",type="string",JSONPath=".status.conditions[?(@.type=='Reachable')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,minio}
+// +kubebuilder:webhook:verbs=create;update,path=/validate-minio-m-crossplane-io-v1beta1-ldapprovider,mutating=false,failurePolicy=fail,groups=minio.m.crossplane.io,resources=ldapproviders,versions=v1beta1,name=ldapproviders.minio.m.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// LDAPProvider is a namespaced managed resource that represents the LDAP or Active Directory
+// identity provider configured on the MinIO server. A server has at most one LDAP provider,
+// so there is at most one LDAPProvider per ProviderConfig across all namespaces.
+type LDAPProvider struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LDAPProviderSpec   `json:"spec"`
+	Status LDAPProviderStatus `json:"status,omitempty"`
+}
+
+// LDAPProviderSpec defines the desired state of an LDAPProvider
+type LDAPProviderSpec struct {
+	xpv1.ManagedResourceSpec `json:",inline"`
+	ForProvider              LDAPProviderParameters `json:"forProvider,omitempty"`
+}
+
+// LDAPProviderStatus defines the observed state of an LDAPProvider
+type LDAPProviderStatus struct {
+	xpv1.ConditionedStatus `json:",inline"`
+	AtProvider             LDAPProviderProviderStatus `json:"atProvider,omitempty"`
+}
+
+// LDAPProviderParameters define the desired state of the MinIO LDAP identity provider
+type LDAPProviderParameters struct {
+	// ServerAddress is the address of the LDAP server including the port, e.g. `ad.example.com:636`.
+	// +kubebuilder:validation:Required
+	ServerAddress string `json:"serverAddress"`
+
+	// LookupBindDN is the DN of the account used to look up users and groups.
+	// +kubebuilder:validation:Required
+	LookupBindDN string `json:"lookupBindDn"`
+
+	// LookupBindPasswordSecretRef references a key of a Secret in the resource's namespace
+	// holding the password of the lookup bind account.
+	LookupBindPasswordSecretRef *xpv1.LocalSecretKeySelector `json:"lookupBindPasswordSecretRef,omitempty"`
+
+	// UserDNSearchBaseDN is the comma separated list of base DNs to search users in.
+	// +kubebuilder:validation:Required
+	UserDNSearchBaseDN string `json:"userDnSearchBaseDn"`
+
+	// UserDNSearchFilter is the filter to find the DN of a user, with `%s` replaced by the username,
+	// e.g. `(&(objectCategory=user)(sAMAccountName=%s))`.
+	// +kubebuilder:validation:Required
+	UserDNSearchFilter string `json:"userDnSearchFilter"`
+
+	// GroupSearchBaseDN is the comma separated list of base DNs to search groups in.
+	GroupSearchBaseDN string `json:"groupSearchBaseDn,omitempty"`
+
+	// GroupSearchFilter is the filter to find the groups of a user, with `%d` replaced by the DN
+	// and `%s` by the username of the user, e.g. `(&(objectclass=group)(member=%d))`.
+	GroupSearchFilter string `json:"groupSearchFilter,omitempty"`
+
+	// StartTLS upgrades a plain connection to the LDAP server with StartTLS.
+	StartTLS bool `json:"startTls,omitempty"`
+
+	// ServerInsecure connects to the LDAP server without TLS. Not recommended.
+	ServerInsecure bool `json:"serverInsecure,omitempty"`
+
+	// TLS configures the verification of the certificate of the LDAP server.
+	// Only InsecureSkipVerify is supported: the MinIO server verifies the certificate
+	// with the CA certificates installed on the server.
+	TLS *common.TLSConfig `json:"tls,omitempty"`
+
+	// Config holds further configuration parameters of the provider, e.g. `user_dn_attributes`.
+	// Run `mc admin idp ldap add --help` for the available parameters.
+	Config map[string]string `json:"config,omitempty"`
+}
+
+// LDAPProviderProviderStatus defines the observed state of an LDAPProvider from the provider
+type LDAPProviderProviderStatus struct {
+	// State is the state of the LDAP server reported by the MinIO server, e.g. `online` or `offline`.
+	State string `json:"state,omitempty"`
+
+	// RestartRequired is true if the MinIO server has to be restarted to apply the last configuration change.
+	RestartRequired bool `json:"restartRequired,omitempty"`
+
+	// ObservedSecretVersion is the resourceVersion of the lookup bind password Secret when the configuration was last applied.
+	ObservedSecretVersion string `json:"observedSecretVersion,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LDAPProviderList contains a list of LDAPProvider resources
+type LDAPProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LDAPProvider `json:"items"`
+}
@@ -0,0 +1,119 @@
+package v1beta1
+
+import (
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="Restart Required",type="boolean",JSONPath=".status.atProvider.restartRequired"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,minio}
+// +kubebuilder:webhook:verbs=create;update,path=/validate-minio-m-crossplane-io-v1beta1-logtarget,mutating=false,failurePolicy=fail,groups=minio.m.crossplane.io,resources=logtargets,versions=v1beta1,name=logtargets.minio.m.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// LogTarget is a namespaced managed resource that represents an audit log or server log target
+// configured on the MinIO server, e.g. the webhook of a SIEM.
+type LogTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LogTargetSpec   `json:"spec"`
+	Status LogTargetStatus `json:"status,omitempty"`
+}
+
+// LogTargetSpec defines the desired state of a LogTarget
+type LogTargetSpec struct {
+	xpv1.ManagedResourceSpec `json:",inline"`
+	ForProvider              LogTargetParameters `json:"forProvider,omitempty"`
+}
+
+// LogTargetStatus defines the observed state of a LogTarget
+type LogTargetStatus struct {
+	xpv1.ConditionedStatus `json:",inline"`
+	AtProvider             LogTargetProviderStatus `json:"atProvider,omitempty"`
+}
+
+// LogTargetParameters define the desired state of a MinIO log target
+type LogTargetParameters struct {
+	// Type is the configuration sub-system of the target.
+	// `audit_webhook` and `audit_kafka` receive the audit log of all API requests, `logger_webhook` receives the server log.
+	// Cannot be changed after the resource is created.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=audit_webhook;audit_kafka;logger_webhook
+	Type string `json:"type"`
+
+	// TargetName is the name of the target on the server.
+	// Defaults to the name of the resource.
+	// Cannot be changed after the resource is created.
+	TargetName string `json:"targetName,omitempty"`
+
+	// Endpoint is the URL of a webhook target or a comma separated list of Kafka brokers.
+	// +kubebuilder:validation:Required
+	Endpoint string `json:"endpoint"`
+
+	// AuthTokenSecretRef references a key of a Secret in the resource's namespace holding
+	// the token that is sent to a webhook target.
+	AuthTokenSecretRef *xpv1.LocalSecretKeySelector `json:"authTokenSecretRef,omitempty"`
+
+	// ClientCert is the path of the client certificate for mTLS on the MinIO server.
+	// Requires ClientKey.
+	ClientCert string `json:"clientCert,omitempty"`
+
+	// ClientKey is the path of the private key of the client certificate on the MinIO server.
+	// Requires ClientCert.
+	ClientKey string `json:"clientKey,omitempty"`
+
+	// BatchSize is the number of log entries sent to a webhook target per request.
+	// +kubebuilder:validation:Minimum=1
+	BatchSize *int64 `json:"batchSize,omitempty"`
+
+	// QueueDir is a directory on the MinIO server where undelivered log entries are stored.
+	QueueDir string `json:"queueDir,omitempty"`
+
+	// QueueSize is the maximum number of undelivered log entries kept by the server.
+	// +kubebuilder:validation:Minimum=1
+	QueueSize *int64 `json:"queueSize,omitempty"`
+
+	// Config holds further configuration parameters of the target, e.g. `topic` for Kafka.
+	// Run `mc admin config set ALIAS <type> --help` for the available parameters.
+	Config map[string]string `json:"config,omitempty"`
+
+	// SecretConfig holds further configuration parameters whose values are read from Secrets
+	// in the resource's namespace, e.g. `sasl_password` for Kafka.
+	SecretConfig map[string]xpv1.LocalSecretKeySelector `json:"secretConfig,omitempty"`
+}
+
+// LogTargetProviderStatus defines the observed state of a LogTarget from the provider
+type LogTargetProviderStatus struct {
+	// RestartRequired is true if the MinIO server has to be restarted to apply the last configuration change.
+	RestartRequired bool `json:"restartRequired,omitempty"`
+
+	// State is the state of the target reported by the server, `online` or `offline`.
+	// Empty if the server does not report the target.
+	State string `json:"state,omitempty"`
+
+	// ObservedSecretVersions are the resourceVersions of the referenced Secrets when the configuration was last applied.
+	ObservedSecretVersions map[string]string `json:"observedSecretVersions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LogTargetList contains a list of LogTarget resources
+type LogTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LogTarget `json:"items"`
+}
+
+// GetTargetName returns the spec.forProvider.targetName if given, otherwise defaults to metadata.name.
+func (in *LogTarget) GetTargetName() string {
+	if in.Spec.ForProvider.TargetName == "" {
+		return in.GetName()
+	}
+	return in.Spec.ForProvider.TargetName
+}
@@ -1,6 +1,10 @@
 package v1beta1
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -27,3 +31,121 @@ func Disabled() xpv1.Condition {
 		LastTransitionTime: metav1.Now(),
 	}
 }
+
+// TypeExpiring indicates whether a resource is about to expire or has expired.
+const TypeExpiring xpv1.ConditionType = "Expiring"
+
+// ExpiringSoon returns an Expiring condition where the resource expires within the warning window.
+func ExpiringSoon(expiration time.Time) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeExpiring,
+		Status:             corev1.ConditionTrue,
+		Reason:             "ExpiringSoon",
+		Message:            fmt.Sprintf("The resource expires at %s", expiration.UTC().Format(time.RFC3339)),
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+// Expired returns an Expiring condition where the resource has expired.
+func Expired(expiration time.Time) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeExpiring,
+		Status:             corev1.ConditionTrue,
+		Reason:             "Expired",
+		Message:            fmt.Sprintf("The resource expired at %s", expiration.UTC().Format(time.RFC3339)),
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+// NotExpiring returns an Expiring condition where the resource does not expire within the warning window.
+func NotExpiring() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeExpiring,
+		Status:             corev1.ConditionFalse,
+		Reason:             "NotExpiring",
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+// TypeTargetsConfigured indicates whether the notification targets referenced by a resource are configured on the server.
+const TypeTargetsConfigured xpv1.ConditionType = "TargetsConfigured"
+
+// TargetNotConfigured returns a TargetsConfigured condition where some of the referenced notification targets are missing.
+func TargetNotConfigured(arns []string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeTargetsConfigured,
+		Status:             corev1.ConditionFalse,
+		Reason:             "TargetNotConfigured",
+		Message:            fmt.Sprintf("Notification targets are not configured on the server: %s", strings.Join(arns, ", ")),
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+// TargetsConfigured returns a TargetsConfigured condition where all referenced notification targets are configured.
+func TargetsConfigured() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeTargetsConfigured,
+		Status:             corev1.ConditionTrue,
+		Reason:             "TargetsConfigured",
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+// TypeTargetOnline indicates whether the server reports a log target as online.
+const TypeTargetOnline xpv1.ConditionType = "TargetOnline"
+
+// TargetOnline returns a TargetOnline condition where the server reports the target as online.
+func TargetOnline() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeTargetOnline,
+		Status:             corev1.ConditionTrue,
+		Reason:             "Online",
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+// TargetOffline returns a TargetOnline condition where the server reports the target as offline.
+func TargetOffline() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeTargetOnline,
+		Status:             corev1.ConditionFalse,
+		Reason:             "Offline",
+		Message:            "The server cannot reach the target, log entries are queued or dropped",
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+// TargetStatusUnknown returns a TargetOnline condition where the status of the target could not be determined.
+func TargetStatusUnknown(message string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeTargetOnline,
+		Status:             corev1.ConditionUnknown,
+		Reason:             "StatusUnknown",
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+// TypeReachable indicates whether an external service used by the server, e.g. an identity provider, is reachable.
+const TypeReachable xpv1.ConditionType = "Reachable"
+
+// Reachable returns a Reachable condition where the service is reachable.
+func Reachable() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeReachable,
+		Status:             corev1.ConditionTrue,
+		Reason:             "Reachable",
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+// Unreachable returns a Reachable condition where the service cannot be reached.
+func Unreachable(message string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeReachable,
+		Status:             corev1.ConditionFalse,
+		Reason:             "Unreachable",
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+	}
+}
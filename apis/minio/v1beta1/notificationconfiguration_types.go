@@ -46,14 +46,47 @@ type NotificationConfigurationParameters struct {
 	// WebhookConfiguration defines webhook notification settings
 	WebhookConfiguration *WebhookConfiguration `json:"webhookConfiguration,omitempty"`
 
-	// QueueConfiguration defines SQS notification settings
+	// QueueConfiguration defines queue notification settings, used for all MinIO notification
+	// targets such as Kafka, AMQP, NATS, Redis, PostgreSQL, MySQL or Elasticsearch
 	QueueConfiguration *QueueConfiguration `json:"queueConfiguration,omitempty"`
 
-	// TopicConfiguration defines SNS notification settings
+	// TopicConfiguration defines SNS notification settings.
+	// It is rejected, MinIO does not support topic configurations.
 	TopicConfiguration *TopicConfiguration `json:"topicConfiguration,omitempty"`
 
-	// Events is the list of S3 events to notify on
+	// Events is the list of S3 events to notify on.
+	// Required if WebhookConfiguration or QueueConfiguration is set.
+	Events []string `json:"events,omitempty"`
+
+	// Filter specifies object key name filtering rules
+	Filter *NotificationFilter `json:"filter,omitempty"`
+
+	// Rules is a list of rules that each route events to one target.
+	// The entries of the bucket notification written by this resource are marked with
+	// its namespace and name, so several resources can manage the same bucket.
+	// +listType=map
+	// +listMapKey=id
+	Rules []NotificationRule `json:"rules,omitempty"`
+}
+
+// NotificationRule routes events of the bucket to a single target.
+// Exactly one of WebhookConfiguration and QueueArn has to be set.
+type NotificationRule struct {
+	// ID identifies the rule within this resource.
 	// +kubebuilder:validation:Required
+	ID string `json:"id"`
+
+	// WebhookConfiguration sends the events to a webhook
+	WebhookConfiguration *WebhookConfiguration `json:"webhookConfiguration,omitempty"`
+
+	// QueueArn is the ARN of the notification target, e.g. `arn:minio:sqs::primary:kafka`.
+	QueueArn string `json:"queueArn,omitempty"`
+
+	// TopicArn is the ARN of an SNS topic.
+	// It is rejected, MinIO does not support topic configurations.
+	TopicArn string `json:"topicArn,omitempty"`
+
+	// Events is the list of S3 events to notify on
 	// +kubebuilder:validation:MinItems=1
 	Events []string `json:"events"`
 
@@ -71,10 +104,13 @@ type WebhookConfiguration struct {
 	// +kubebuilder:validation:Required
 	Endpoint string `json:"endpoint"`
 
-	// AuthToken is an optional authentication token for the webhook
+	// AuthToken is an optional authentication token for the webhook.
+	// Deprecated: It has no effect, as bucket notifications cannot configure the webhook on the server.
+	// Use a NotificationTarget with authTokenSecretRef instead.
 	AuthToken *string `json:"authToken,omitempty"`
 
-	// UserAgent is an optional custom user agent string
+	// UserAgent is an optional custom user agent string.
+	// Deprecated: It has no effect, as bucket notifications cannot configure the webhook on the server.
 	UserAgent *string `json:"userAgent,omitempty"`
 }
 
@@ -84,7 +120,8 @@ type QueueConfiguration struct {
 	// +kubebuilder:validation:Required
 	ID string `json:"id"`
 
-	// QueueArn is the ARN of the SQS queue
+	// QueueArn is the ARN of the notification target, e.g. `arn:minio:sqs::primary:kafka`.
+	// The target has to be configured on the MinIO server.
 	// +kubebuilder:validation:Required
 	QueueArn string `json:"queueArn"`
 }
@@ -100,7 +137,8 @@ type TopicConfiguration struct {
 	TopicArn string `json:"topicArn"`
 }
 
-// NotificationFilter specifies object key name filtering rules
+// NotificationFilter specifies object key name filtering rules.
+// Bucket notifications cannot filter by object tags or metadata, neither in MinIO nor in the S3 API.
 type NotificationFilter struct {
 	// Key specifies object key name filtering rules
 	Key *KeyFilter `json:"key,omitempty"`
@@ -108,7 +146,8 @@ type NotificationFilter struct {
 
 // KeyFilter specifies object key name filtering rules
 type KeyFilter struct {
-	// FilterRules is the list of filter rules
+	// FilterRules is the list of filter rules, with at most one prefix and one suffix rule.
+	// The order of the rules is not significant.
 	FilterRules []FilterRule `json:"filterRules,omitempty"`
 }
 
@@ -0,0 +1,104 @@
+package v1beta1
+
+import (
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".status.atProvider.arn"
+// +kubebuilder:printcolumn:name="Restart Required",type="boolean",JSONPath=".status.atProvider.restartRequired"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,minio}
+// +kubebuilder:webhook:verbs=create;update,path=/validate-minio-m-crossplane-io-v1beta1-notificationtarget,mutating=false,failurePolicy=fail,groups=minio.m.crossplane.io,resources=notificationtargets,versions=v1beta1,name=notificationtargets.minio.m.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// NotificationTarget is a namespaced managed resource that represents a bucket notification target
+// configured on the MinIO server, e.g. a webhook or a Kafka cluster.
+type NotificationTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NotificationTargetSpec   `json:"spec"`
+	Status NotificationTargetStatus `json:"status,omitempty"`
+}
+
+// NotificationTargetSpec defines the desired state of a NotificationTarget
+type NotificationTargetSpec struct {
+	xpv1.ManagedResourceSpec `json:",inline"`
+	ForProvider              NotificationTargetParameters `json:"forProvider,omitempty"`
+}
+
+// NotificationTargetStatus defines the observed state of a NotificationTarget
+type NotificationTargetStatus struct {
+	xpv1.ConditionedStatus `json:",inline"`
+	AtProvider             NotificationTargetProviderStatus `json:"atProvider,omitempty"`
+}
+
+// NotificationTargetParameters define the desired state of a MinIO notification target
+type NotificationTargetParameters struct {
+	// Type is the kind of the target. It selects the notify_<type> configuration sub-system of the server.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=webhook;kafka;amqp;nats;redis;postgresql;mysql;elasticsearch;mqtt;nsq
+	Type string `json:"type"`
+
+	// TargetID is the ID of the target on the server. It is part of the ARN used in bucket notifications.
+	// Defaults to the name of the resource.
+	TargetID string `json:"targetId,omitempty"`
+
+	// Endpoint is the address of the target, e.g. the URL of a webhook or a comma separated list of Kafka brokers.
+	// It is written to the configuration parameter of the target type that holds its address
+	// (endpoint, brokers, url, address, connection_string, dsn_string, broker or nsqd_address).
+	// +kubebuilder:validation:Required
+	Endpoint string `json:"endpoint"`
+
+	// AuthTokenSecretRef references a key of a Secret in the resource's namespace holding
+	// the token that is sent to a webhook target.
+	AuthTokenSecretRef *xpv1.LocalSecretKeySelector `json:"authTokenSecretRef,omitempty"`
+
+	// QueueDir is a directory on the MinIO server where undelivered events are stored.
+	QueueDir string `json:"queueDir,omitempty"`
+
+	// QueueLimit is the maximum number of undelivered events stored in QueueDir.
+	// +kubebuilder:validation:Minimum=1
+	QueueLimit *int64 `json:"queueLimit,omitempty"`
+
+	// Config holds further configuration parameters of the target type, e.g. `topic` for Kafka
+	// or `exchange` for AMQP. Run `mc admin config set ALIAS notify_<type> --help` for the available parameters.
+	Config map[string]string `json:"config,omitempty"`
+
+	// SecretConfig holds further configuration parameters whose values are read from Secrets
+	// in the resource's namespace, e.g. `sasl_password` for Kafka.
+	SecretConfig map[string]xpv1.LocalSecretKeySelector `json:"secretConfig,omitempty"`
+}
+
+// NotificationTargetProviderStatus defines the observed state of a NotificationTarget from the provider
+type NotificationTargetProviderStatus struct {
+	// ARN is the ARN of the target to be used in bucket notifications, e.g. `arn:minio:sqs::primary:webhook`.
+	ARN string `json:"arn,omitempty"`
+
+	// RestartRequired is true if the MinIO server has to be restarted to apply the last configuration change.
+	RestartRequired bool `json:"restartRequired,omitempty"`
+
+	// ObservedSecretVersions are the resourceVersions of the referenced Secrets when the configuration was last applied.
+	ObservedSecretVersions map[string]string `json:"observedSecretVersions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NotificationTargetList contains a list of NotificationTarget resources
+type NotificationTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NotificationTarget `json:"items"`
+}
+
+// GetTargetID returns the spec.forProvider.targetId if given, otherwise defaults to metadata.name.
+func (in *NotificationTarget) GetTargetID() string {
+	if in.Spec.ForProvider.TargetID == "" {
+		return in.GetName()
+	}
+	return in.Spec.ForProvider.TargetID
+}
@@ -0,0 +1,108 @@
+package v1beta1
+
+import (
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="Reachable",type="string",JSONPath=".status.conditions[?(@.type=='Reachable')].status"
+// +kubebuilder:printcolumn:name="Role ARN",type="string",JSONPath=".status.atProvider.roleArn"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,minio}
+// +kubebuilder:webhook:verbs=create;update,path=/validate-minio-m-crossplane-io-v1beta1-openidprovider,mutating=false,failurePolicy=fail,groups=minio.m.crossplane.io,resources=openidproviders,versions=v1beta1,name=openidproviders.minio.m.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// OpenIDProvider is a namespaced managed resource that represents an OpenID Connect
+// identity provider configured on the MinIO server.
+type OpenIDProvider struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenIDProviderSpec   `json:"spec"`
+	Status OpenIDProviderStatus `json:"status,omitempty"`
+}
+
+// OpenIDProviderSpec defines the desired state of an OpenIDProvider
+type OpenIDProviderSpec struct {
+	xpv1.ManagedResourceSpec `json:",inline"`
+	ForProvider              OpenIDProviderParameters `json:"forProvider,omitempty"`
+}
+
+// OpenIDProviderStatus defines the observed state of an OpenIDProvider
+type OpenIDProviderStatus struct {
+	xpv1.ConditionedStatus `json:",inline"`
+	AtProvider             OpenIDProviderProviderStatus `json:"atProvider,omitempty"`
+}
+
+// OpenIDProviderParameters define the desired state of a MinIO OpenID Connect identity provider
+type OpenIDProviderParameters struct {
+	// ProviderName is the name of the identity provider configuration on the server.
+	// `_` selects the default configuration. Defaults to the name of the resource.
+	// Cannot be changed after the resource is created.
+	ProviderName string `json:"providerName,omitempty"`
+
+	// ConfigURL is the https URL of the OpenID discovery document,
+	// e.g. `https://accounts.example.com/.well-known/openid-configuration`.
+	// +kubebuilder:validation:Required
+	ConfigURL string `json:"configUrl"`
+
+	// ClientID is the client ID of MinIO at the identity provider.
+	// +kubebuilder:validation:Required
+	ClientID string `json:"clientId"`
+
+	// ClientSecretSecretRef references a key of a Secret in the resource's namespace holding the client secret.
+	ClientSecretSecretRef *xpv1.LocalSecretKeySelector `json:"clientSecretSecretRef,omitempty"`
+
+	// ClaimName is the name of the JWT claim holding the names of the policies to attach, e.g. `groups`.
+	// Cannot be combined with RolePolicy.
+	ClaimName string `json:"claimName,omitempty"`
+
+	// Scopes are the scopes requested from the identity provider, e.g. `openid` and `groups`.
+	Scopes []string `json:"scopes,omitempty"`
+
+	// RolePolicy is a comma separated list of policies attached to all users of the provider.
+	// The server then issues a role ARN for the provider. Cannot be combined with ClaimName.
+	RolePolicy string `json:"rolePolicy,omitempty"`
+
+	// Config holds further configuration parameters of the provider, e.g. `redirect_uri` or `display_name`.
+	// Run `mc admin idp openid add --help` for the available parameters.
+	Config map[string]string `json:"config,omitempty"`
+}
+
+// OpenIDProviderProviderStatus defines the observed state of an OpenIDProvider from the provider
+type OpenIDProviderProviderStatus struct {
+	// RoleARN is the role ARN the server issued for a provider with a role policy.
+	RoleARN string `json:"roleArn,omitempty"`
+
+	// Enabled is true if the provider is enabled on the server.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// RestartRequired is true if the MinIO server has to be restarted to apply the last configuration change.
+	RestartRequired bool `json:"restartRequired,omitempty"`
+
+	// ObservedSecretVersion is the resourceVersion of the client secret Secret when the configuration was last applied.
+	ObservedSecretVersion string `json:"observedSecretVersion,omitempty"`
+
+	// ReachabilityCheckedAt is the time the provider last fetched the discovery document.
+	ReachabilityCheckedAt *metav1.Time `json:"reachabilityCheckedAt,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OpenIDProviderList contains a list of OpenIDProvider resources
+type OpenIDProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenIDProvider `json:"items"`
+}
+
+// GetProviderName returns the spec.forProvider.providerName if given, otherwise defaults to metadata.name.
+func (in *OpenIDProvider) GetProviderName() string {
+	if in.Spec.ForProvider.ProviderName == "" {
+		return in.GetName()
+	}
+	return in.Spec.ForProvider.ProviderName
+}
@@ -54,6 +54,62 @@ var (
 	NotificationConfigurationGroupVersionKind = SchemeGroupVersion.WithKind(NotificationConfigurationKind)
 )
 
+// NotificationTarget type metadata.
+var (
+	NotificationTargetKind             = reflect.TypeOf(NotificationTarget{}).Name()
+	NotificationTargetGroupKind        = schema.GroupKind{Group: Group, Kind: NotificationTargetKind}.String()
+	NotificationTargetKindAPIVersion   = NotificationTargetKind + "." + SchemeGroupVersion.String()
+	NotificationTargetGroupVersionKind = SchemeGroupVersion.WithKind(NotificationTargetKind)
+)
+
+// LDAPProvider type metadata.
+var (
+	LDAPProviderKind             = reflect.TypeOf(LDAPProvider{}).Name()
+	LDAPProviderGroupKind        = schema.GroupKind{Group: Group, Kind: LDAPProviderKind}.String()
+	LDAPProviderKindAPIVersion   = LDAPProviderKind + "." + SchemeGroupVersion.String()
+	LDAPProviderGroupVersionKind = SchemeGroupVersion.WithKind(LDAPProviderKind)
+)
+
+// LDAPPolicyMapping type metadata.
+var (
+	LDAPPolicyMappingKind             = reflect.TypeOf(LDAPPolicyMapping{}).Name()
+	LDAPPolicyMappingGroupKind        = schema.GroupKind{Group: Group, Kind: LDAPPolicyMappingKind}.String()
+	LDAPPolicyMappingKindAPIVersion   = LDAPPolicyMappingKind + "." + SchemeGroupVersion.String()
+	LDAPPolicyMappingGroupVersionKind = SchemeGroupVersion.WithKind(LDAPPolicyMappingKind)
+)
+
+// OpenIDProvider type metadata.
+var (
+	OpenIDProviderKind             = reflect.TypeOf(OpenIDProvider{}).Name()
+	OpenIDProviderGroupKind        = schema.GroupKind{Group: Group, Kind: OpenIDProviderKind}.String()
+	OpenIDProviderKindAPIVersion   = OpenIDProviderKind + "." + SchemeGroupVersion.String()
+	OpenIDProviderGroupVersionKind = SchemeGroupVersion.WithKind(OpenIDProviderKind)
+)
+
+// LogTarget type metadata.
+var (
+	LogTargetKind             = reflect.TypeOf(LogTarget{}).Name()
+	LogTargetGroupKind        = schema.GroupKind{Group: Group, Kind: LogTargetKind}.String()
+	LogTargetKindAPIVersion   = LogTargetKind + "." + SchemeGroupVersion.String()
+	LogTargetGroupVersionKind = SchemeGroupVersion.WithKind(LogTargetKind)
+)
+
+// ServerConfig type metadata.
+var (
+	ServerConfigKind             = reflect.TypeOf(ServerConfig{}).Name()
+	ServerConfigGroupKind        = schema.GroupKind{Group: Group, Kind: ServerConfigKind}.String()
+	ServerConfigKindAPIVersion   = ServerConfigKind + "." + SchemeGroupVersion.String()
+	ServerConfigGroupVersionKind = SchemeGroupVersion.WithKind(ServerConfigKind)
+)
+
+// Tier type metadata.
+var (
+	TierKind             = reflect.TypeOf(Tier{}).Name()
+	TierGroupKind        = schema.GroupKind{Group: Group, Kind: TierKind}.String()
+	TierKindAPIVersion   = TierKind + "." + SchemeGroupVersion.String()
+	TierGroupVersionKind = SchemeGroupVersion.WithKind(TierKind)
+)
+
 // Policy type metadata.
 var (
 	PolicyKind             = reflect.TypeOf(Policy{}).Name()
@@ -0,0 +1,84 @@
+package v1beta1
+
+import (
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="Sub-System",type="string",JSONPath=".spec.forProvider.subSystem"
+// +kubebuilder:printcolumn:name="Target",type="string",JSONPath=".spec.forProvider.target"
+// +kubebuilder:printcolumn:name="Restart Required",type="boolean",JSONPath=".status.atProvider.restartRequired"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,minio}
+// +kubebuilder:webhook:verbs=create;update,path=/validate-minio-m-crossplane-io-v1beta1-serverconfig,mutating=false,failurePolicy=fail,groups=minio.m.crossplane.io,resources=serverconfigs,versions=v1beta1,name=serverconfigs.minio.m.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// ServerConfig is a namespaced managed resource that represents the configuration
+// of a MinIO server configuration sub-system, e.g. `api` or `scanner`.
+type ServerConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServerConfigSpec   `json:"spec"`
+	Status ServerConfigStatus `json:"status,omitempty"`
+}
+
+// ServerConfigSpec defines the desired state of a ServerConfig
+type ServerConfigSpec struct {
+	xpv1.ManagedResourceSpec `json:",inline"`
+	ForProvider              ServerConfigParameters `json:"forProvider,omitempty"`
+}
+
+// ServerConfigStatus defines the observed state of a ServerConfig
+type ServerConfigStatus struct {
+	xpv1.ConditionedStatus `json:",inline"`
+	AtProvider             ServerConfigProviderStatus `json:"atProvider,omitempty"`
+}
+
+// ServerConfigParameters define the desired state of a MinIO configuration sub-system
+type ServerConfigParameters struct {
+	// SubSystem is the configuration sub-system, e.g. `api`, `scanner`, `compression`, `heal`, `audit_webhook` or `identity_openid`.
+	// Run `mc admin config get ALIAS` for the sub-systems of a server.
+	// Cannot be changed after the resource is created.
+	// +kubebuilder:validation:Required
+	SubSystem string `json:"subSystem"`
+
+	// Target is the name of the target for sub-systems that support several targets, e.g. `audit_webhook`.
+	// The default target is used if unset.
+	// Cannot be changed after the resource is created.
+	Target string `json:"target,omitempty"`
+
+	// Config holds the configuration parameters of the sub-system.
+	// Run `mc admin config set ALIAS <sub-system> --help` for the available parameters.
+	// Parameters that are not set keep their value on the server.
+	Config map[string]string `json:"config,omitempty"`
+
+	// SecretConfig holds configuration parameters whose values are read from Secrets
+	// in the resource's namespace, e.g. `client_secret` for `identity_openid`.
+	SecretConfig map[string]xpv1.LocalSecretKeySelector `json:"secretConfig,omitempty"`
+}
+
+// ServerConfigProviderStatus defines the observed state of a ServerConfig from the provider
+type ServerConfigProviderStatus struct {
+	// RestartRequired is true if the MinIO server has to be restarted to apply the last configuration change.
+	RestartRequired bool `json:"restartRequired,omitempty"`
+
+	// EnvOverriddenKeys are the configuration parameters that are overridden by environment variables on the server.
+	// They are not compared, as the server ignores their configured values.
+	EnvOverriddenKeys []string `json:"envOverriddenKeys,omitempty"`
+
+	// ObservedSecretVersions are the resourceVersions of the referenced Secrets when the configuration was last applied.
+	ObservedSecretVersions map[string]string `json:"observedSecretVersions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServerConfigList contains a list of ServerConfig resources
+type ServerConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServerConfig `json:"items"`
+}
@@ -52,6 +52,20 @@ type ServiceAccountProviderStatus struct {
 	Policy string `json:"policy,omitempty"`
 	// Expiration shows when this service account expires (if set)
 	Expiration *metav1.Time `json:"expiration,omitempty"`
+
+	// LastRotation is the time the current service account was created by a rotation
+	// or, for the first service account, when rotation was first observed.
+	LastRotation *metav1.Time `json:"lastRotation,omitempty"`
+	// NextRotation is the time the service account will be replaced.
+	NextRotation *metav1.Time `json:"nextRotation,omitempty"`
+	// PreviousAccessKey is the access key of the replaced service account that is kept during the overlap period.
+	PreviousAccessKey string `json:"previousAccessKey,omitempty"`
+	// PreviousAccessKeyDeletion is the time the replaced service account will be deleted.
+	PreviousAccessKeyDeletion *metav1.Time `json:"previousAccessKeyDeletion,omitempty"`
+
+	// ObservedSecretKeyVersion is the resource version of the Secret referenced
+	// by SecretKeySecretRef that was last applied to the service account.
+	ObservedSecretKeyVersion string `json:"observedSecretKeyVersion,omitempty"`
 }
 
 // ServiceAccountParameters define the desired state of a MinIO ServiceAccount
@@ -69,8 +83,14 @@ type ServiceAccountParameters struct {
 	// SecretKey is the desired secret key for the service account.
 	// If not specified, MinIO will generate one automatically.
 	// Cannot be changed after service account is created.
+	// Deprecated: the value is stored in plaintext, use SecretKeySecretRef instead.
 	SecretKey string `json:"secretKey,omitempty"`
 
+	// SecretKeySecretRef references a key of a Secret in the service account's
+	// namespace holding the desired secret key. Requires AccessKey to be set.
+	// Cannot be combined with SecretKey.
+	SecretKeySecretRef *xpv1.LocalSecretKeySelector `json:"secretKeySecretRef,omitempty"`
+
 	// Name is a human-readable name for this service account
 	Name string `json:"name,omitempty"`
 
@@ -82,10 +102,42 @@ type ServiceAccountParameters struct {
 	// will inherit the policies of the target user.
 	Policy string `json:"policy,omitempty"`
 
+	// PolicyRefs references Policy resources in the service account's namespace.
+	// Their documents are merged with Policy and CannedPolicies into the policy of the service account.
+	// The service account is updated whenever a referenced policy changes.
+	PolicyRefs []xpv1.Reference `json:"policyRefs,omitempty"`
+
+	// CannedPolicies is a list of names of policies that exist on the MinIO server.
+	// Their documents are merged with Policy and PolicyRefs into the policy of the service account.
+	CannedPolicies []string `json:"cannedPolicies,omitempty"`
+
 	// Expiration defines when this service account should expire.
 	// If not specified, the service account will not expire.
 	Expiration *metav1.Time `json:"expiration,omitempty"`
 
+	// TTL is the lifetime of the service account, e.g. `720h`.
+	// The expiration is set to the current time plus TTL and renewed once less than half of the TTL remains.
+	// An expired service account is recreated.
+	// Cannot be combined with Expiration.
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// ExpiryWarningWindow is how long before the expiration the Expiring condition
+	// is set and a Warning event is emitted, e.g. `72h`.
+	// +kubebuilder:default="72h"
+	ExpiryWarningWindow metav1.Duration `json:"expiryWarningWindow,omitempty"`
+
+	// AccountStatus is the desired status of the service account.
+	// A service account that is off keeps its credentials and policy but cannot authenticate.
+	// +kubebuilder:validation:Enum=on;off
+	// +kubebuilder:default=on
+	AccountStatus string `json:"accountStatus,omitempty"`
+
+	// Rotation configures the periodic replacement of the service account.
+	// A rotation creates a new service account with a new access key, publishes the new and the previous
+	// credentials to the connection secret and deletes the previous service account after the overlap period.
+	// Cannot be combined with AccessKey or SecretKey.
+	Rotation *ServiceAccountRotation `json:"rotation,omitempty"`
+
 	// WriteConnectionSecretsToRef specifies the namespace and name of a
 	// Secret to which any connection details for this managed resource should
 	// be written. Connection details frequently include the endpoint, username,
@@ -97,6 +149,18 @@ type ServiceAccountParameters struct {
 	WriteConnectionSecretsToRef *xpv1.SecretReference `json:"writeConnectionSecretsToRef,omitempty"`
 }
 
+// ServiceAccountRotation defines when a service account is replaced.
+type ServiceAccountRotation struct {
+	// IntervalDays is the number of days after which the service account is replaced.
+	// +kubebuilder:validation:Minimum=1
+	IntervalDays int `json:"intervalDays"`
+
+	// OverlapPeriod is how long the previous service account stays valid after a rotation, e.g. `24h`.
+	// Consumers have to pick up the new credentials within this period.
+	// +kubebuilder:default="1h"
+	OverlapPeriod metav1.Duration `json:"overlapPeriod,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 
 // ServiceAccountList contains a list of ServiceAccount resources
@@ -113,3 +177,11 @@ func (in *ServiceAccount) GetAccessKey() string {
 	}
 	return in.Spec.ForProvider.AccessKey
 }
+
+// GetAccountStatus returns the spec.forProvider.accountStatus if given, otherwise defaults to `on`.
+func (in *ServiceAccount) GetAccountStatus() string {
+	if in.Spec.ForProvider.AccountStatus == "" {
+		return "on"
+	}
+	return in.Spec.ForProvider.AccountStatus
+}
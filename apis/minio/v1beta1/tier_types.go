@@ -0,0 +1,119 @@
+package v1beta1
+
+import (
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="Tier Name",type="string",JSONPath=".status.atProvider.tierName"
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="Objects",type="integer",JSONPath=".status.atProvider.numObjects"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,minio}
+// +kubebuilder:webhook:verbs=create;update,path=/validate-minio-m-crossplane-io-v1beta1-tier,mutating=false,failurePolicy=fail,groups=minio.m.crossplane.io,resources=tiers,versions=v1beta1,name=tiers.minio.m.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// Tier is a namespaced managed resource that represents a remote storage tier
+// that ILM transition rules move objects to.
+type Tier struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TierSpec   `json:"spec"`
+	Status TierStatus `json:"status,omitempty"`
+}
+
+// TierSpec defines the desired state of a Tier
+type TierSpec struct {
+	xpv1.ManagedResourceSpec `json:",inline"`
+	ForProvider              TierParameters `json:"forProvider,omitempty"`
+}
+
+// TierStatus defines the observed state of a Tier
+type TierStatus struct {
+	xpv1.ConditionedStatus `json:",inline"`
+	AtProvider             TierProviderStatus `json:"atProvider,omitempty"`
+}
+
+// TierParameters define the desired state of a MinIO remote tier
+type TierParameters struct {
+	// TierName is the name of the tier on the server. Lifecycle transition rules reference it as storage class.
+	// Defaults to `metadata.name` in upper case.
+	// Cannot be changed after the tier is created.
+	TierName string `json:"tierName,omitempty"`
+
+	// Type is the kind of the remote storage.
+	// Cannot be changed after the tier is created.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=s3;azure;gcs;minio
+	Type string `json:"type"`
+
+	// Endpoint is the URL of the remote storage. Required for `minio`, defaults to the public endpoint of the cloud provider otherwise.
+	// Cannot be changed after the tier is created.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Bucket is the bucket (or Azure container) on the remote storage that objects are moved to.
+	// Cannot be changed after the tier is created.
+	// +kubebuilder:validation:Required
+	Bucket string `json:"bucket"`
+
+	// Prefix is the prefix of the objects in the remote bucket.
+	// Cannot be changed after the tier is created.
+	Prefix string `json:"prefix,omitempty"`
+
+	// Region is the region of the remote storage.
+	// Cannot be changed after the tier is created.
+	Region string `json:"region,omitempty"`
+
+	// StorageClass is the storage class of the objects on the remote storage, e.g. `GLACIER` for S3.
+	// Not supported by `minio`.
+	// Cannot be changed after the tier is created.
+	StorageClass string `json:"storageClass,omitempty"`
+
+	// CredentialsSecretRef references a Secret in the resource's namespace holding the credentials of the remote storage.
+	// The Secret has the keys `accessKey` and `secretKey` for `s3` and `minio`, `accountName` and `accountKey` for `azure`
+	// and `credentials` with the content of a service account JSON key file for `gcs`.
+	// Credentials are updated on the server when the Secret changes.
+	// +kubebuilder:validation:Required
+	CredentialsSecretRef xpv1.LocalSecretReference `json:"credentialsSecretRef"`
+}
+
+// TierProviderStatus defines the observed state of a Tier from the provider
+type TierProviderStatus struct {
+	// TierName is the name of the tier on the server.
+	TierName string `json:"tierName,omitempty"`
+
+	// TotalSize is the total size in bytes of the objects moved to the tier.
+	TotalSize int64 `json:"totalSize,omitempty"`
+
+	// NumObjects is the number of objects moved to the tier.
+	NumObjects int64 `json:"numObjects,omitempty"`
+
+	// NumVersions is the number of object versions moved to the tier.
+	NumVersions int64 `json:"numVersions,omitempty"`
+
+	// ObservedSecretVersion is the resourceVersion of the credentials Secret when the credentials were last applied.
+	ObservedSecretVersion string `json:"observedSecretVersion,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TierList contains a list of Tier resources
+type TierList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Tier `json:"items"`
+}
+
+// GetTierName returns the spec.forProvider.tierName if given, otherwise defaults to metadata.name in upper case.
+func (in *Tier) GetTierName() string {
+	if in.Spec.ForProvider.TierName == "" {
+		return strings.ToUpper(in.GetName())
+	}
+	return in.Spec.ForProvider.TierName
+}
@@ -45,7 +45,28 @@ type UserProviderStatus struct {
 	Status string `json:"status,omitempty"`
 
 	// Policies contains a list of policies that are applied to this user
-	Policies string `json:"policies,omitempty"`
+	Policies []string `json:"policies,omitempty"`
+
+	// Groups contains the list of groups the user is a member of.
+	Groups []string `json:"groups,omitempty"`
+
+	// LastSecretRotation is the time the user's secret key was last set by the provider.
+	LastSecretRotation *metav1.Time `json:"lastSecretRotation,omitempty"`
+
+	// ObservedRotateAt is the value of the `minio.crossplane.io/rotate-at` annotation
+	// that triggered the last rotation.
+	ObservedRotateAt string `json:"observedRotateAt,omitempty"`
+
+	// LastCredentialVerification is the time the user's credentials were last verified successfully.
+	LastCredentialVerification *metav1.Time `json:"lastCredentialVerification,omitempty"`
+
+	// CredentialsUpdatedAt is the time MinIO reported as the last change of the
+	// user after the provider last set its credentials or account status.
+	CredentialsUpdatedAt *metav1.Time `json:"credentialsUpdatedAt,omitempty"`
+
+	// ObservedSecretKeyVersion is the resource version of the Secret referenced
+	// by SecretKeySecretRef that was last applied to the user.
+	ObservedSecretKeyVersion string `json:"observedSecretKeyVersion,omitempty"`
 }
 
 // UserParameters define the desired state of a MinIO User
@@ -58,6 +79,56 @@ type UserParameters struct {
 	// Policies contains a list of policies that should get assigned to this user.
 	// These policies need to be created separately by using the policy CRD.
 	Policies []string `json:"policies,omitempty"`
+
+	// Groups contains the list of groups the user is a member of.
+	// Memberships in groups that are not listed are removed; an empty list removes the user from all groups.
+	// If unset, group membership is not managed.
+	Groups *[]string `json:"groups,omitempty"`
+
+	// CreateGroups allows the provider to create listed groups that don't exist yet.
+	// If false, reconciliation fails until the group has been created.
+	CreateGroups bool `json:"createGroups,omitempty"`
+
+	// AccountStatus is the desired status of the user's account.
+	// Disabled users keep their credentials and policies but cannot authenticate.
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +kubebuilder:default=enabled
+	AccountStatus string `json:"accountStatus,omitempty"`
+
+	// SecretKeySecretRef references a key of a Secret in the user's namespace
+	// holding the secret key to set on the user.
+	// The secret key is re-applied whenever the referenced Secret changes.
+	// If unset, a random secret key is generated.
+	// Cannot be combined with SecretRotation.
+	SecretKeySecretRef *xpv1.LocalSecretKeySelector `json:"secretKeySecretRef,omitempty"`
+
+	// CredentialVerification enables periodic verification that the user's
+	// credentials have not been changed outside of the provider, by comparing
+	// the time MinIO reports as the user's last change with the recorded one.
+	// The connection secret is never read. If the credentials were changed, the
+	// referenced secret key is re-applied or a new secret key is generated.
+	// Verification is disabled if unset.
+	CredentialVerification *CredentialVerification `json:"credentialVerification,omitempty"`
+
+	// SecretRotation configures the periodic rotation of the user's secret key.
+	// Independently of this setting, the secret key is rotated whenever the
+	// `minio.crossplane.io/rotate-at` annotation is set to a new value.
+	SecretRotation *SecretRotation `json:"secretRotation,omitempty"`
+}
+
+// CredentialVerification defines how often credentials are verified.
+type CredentialVerification struct {
+	// Interval is the minimum time between two verifications, e.g. `1h`.
+	// +kubebuilder:default="1h"
+	Interval metav1.Duration `json:"interval,omitempty"`
+}
+
+// SecretRotation defines when a secret key is rotated.
+type SecretRotation struct {
+	// IntervalDays is the maximum age of the secret key in days.
+	// The secret key is rotated once it is older.
+	// +kubebuilder:validation:Minimum=1
+	IntervalDays int `json:"intervalDays"`
 }
 
 // +kubebuilder:object:root=true
@@ -76,3 +147,11 @@ func (in *User) GetUserName() string {
 	}
 	return in.Spec.ForProvider.UserName
 }
+
+// GetAccountStatus returns the spec.forProvider.accountStatus if given, otherwise defaults to `enabled`.
+func (in *User) GetAccountStatus() string {
+	if in.Spec.ForProvider.AccountStatus == "" {
+		return "enabled"
+	}
+	return in.Spec.ForProvider.AccountStatus
+}
@@ -6,6 +6,8 @@ package v1beta1
 
 import (
 	"github.com/crossplane/crossplane/apis/v2/core/v2"
+	"github.com/rossigee/provider-minio/apis/common"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -36,6 +38,41 @@ func (in *Bucket) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketLifecycleRule) DeepCopyInto(out *BucketLifecycleRule) {
+	*out = *in
+	if in.Transition != nil {
+		in, out := &in.Transition, &out.Transition
+		*out = new(BucketLifecycleTransition)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketLifecycleRule.
+func (in *BucketLifecycleRule) DeepCopy() *BucketLifecycleRule {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketLifecycleRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketLifecycleTransition) DeepCopyInto(out *BucketLifecycleTransition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketLifecycleTransition.
+func (in *BucketLifecycleTransition) DeepCopy() *BucketLifecycleTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketLifecycleTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketList) DeepCopyInto(out *BucketList) {
 	*out = *in
@@ -83,6 +120,17 @@ func (in *BucketParameters) DeepCopyInto(out *BucketParameters) {
 			(*out)[key] = val
 		}
 	}
+	if in.LifecycleRules != nil {
+		in, out := &in.LifecycleRules, &out.LifecycleRules
+		*out = new([]BucketLifecycleRule)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]BucketLifecycleRule, len(*in))
+			for i := range *in {
+				(*in)[i].DeepCopyInto(&(*out)[i])
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketParameters.
@@ -144,6 +192,22 @@ func (in *BucketStatus) DeepCopy() *BucketStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialVerification) DeepCopyInto(out *CredentialVerification) {
+	*out = *in
+	out.Interval = in.Interval
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialVerification.
+func (in *CredentialVerification) DeepCopy() *CredentialVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FilterRule) DeepCopyInto(out *FilterRule) {
 	*out = *in
@@ -180,7 +244,7 @@ func (in *KeyFilter) DeepCopy() *KeyFilter {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NotificationConfiguration) DeepCopyInto(out *NotificationConfiguration) {
+func (in *LDAPPolicyMapping) DeepCopyInto(out *LDAPPolicyMapping) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -188,18 +252,18 @@ func (in *NotificationConfiguration) DeepCopyInto(out *NotificationConfiguration
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationConfiguration.
-func (in *NotificationConfiguration) DeepCopy() *NotificationConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPPolicyMapping.
+func (in *LDAPPolicyMapping) DeepCopy() *LDAPPolicyMapping {
 	if in == nil {
 		return nil
 	}
-	out := new(NotificationConfiguration)
+	out := new(LDAPPolicyMapping)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NotificationConfiguration) DeepCopyObject() runtime.Object {
+func (in *LDAPPolicyMapping) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -207,31 +271,31 @@ func (in *NotificationConfiguration) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NotificationConfigurationList) DeepCopyInto(out *NotificationConfigurationList) {
+func (in *LDAPPolicyMappingList) DeepCopyInto(out *LDAPPolicyMappingList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NotificationConfiguration, len(*in))
+		*out = make([]LDAPPolicyMapping, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationConfigurationList.
-func (in *NotificationConfigurationList) DeepCopy() *NotificationConfigurationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPPolicyMappingList.
+func (in *LDAPPolicyMappingList) DeepCopy() *LDAPPolicyMappingList {
 	if in == nil {
 		return nil
 	}
-	out := new(NotificationConfigurationList)
+	out := new(LDAPPolicyMappingList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NotificationConfigurationList) DeepCopyObject() runtime.Object {
+func (in *LDAPPolicyMappingList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -239,120 +303,81 @@ func (in *NotificationConfigurationList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NotificationConfigurationParameters) DeepCopyInto(out *NotificationConfigurationParameters) {
+func (in *LDAPPolicyMappingParameters) DeepCopyInto(out *LDAPPolicyMappingParameters) {
 	*out = *in
-	if in.WebhookConfiguration != nil {
-		in, out := &in.WebhookConfiguration, &out.WebhookConfiguration
-		*out = new(WebhookConfiguration)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.QueueConfiguration != nil {
-		in, out := &in.QueueConfiguration, &out.QueueConfiguration
-		*out = new(QueueConfiguration)
-		**out = **in
-	}
-	if in.TopicConfiguration != nil {
-		in, out := &in.TopicConfiguration, &out.TopicConfiguration
-		*out = new(TopicConfiguration)
-		**out = **in
-	}
-	if in.Events != nil {
-		in, out := &in.Events, &out.Events
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.Filter != nil {
-		in, out := &in.Filter, &out.Filter
-		*out = new(NotificationFilter)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationConfigurationParameters.
-func (in *NotificationConfigurationParameters) DeepCopy() *NotificationConfigurationParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPPolicyMappingParameters.
+func (in *LDAPPolicyMappingParameters) DeepCopy() *LDAPPolicyMappingParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(NotificationConfigurationParameters)
+	out := new(LDAPPolicyMappingParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NotificationConfigurationProviderStatus) DeepCopyInto(out *NotificationConfigurationProviderStatus) {
+func (in *LDAPPolicyMappingProviderStatus) DeepCopyInto(out *LDAPPolicyMappingProviderStatus) {
 	*out = *in
-	if in.LastUpdated != nil {
-		in, out := &in.LastUpdated, &out.LastUpdated
-		*out = (*in).DeepCopy()
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationConfigurationProviderStatus.
-func (in *NotificationConfigurationProviderStatus) DeepCopy() *NotificationConfigurationProviderStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPPolicyMappingProviderStatus.
+func (in *LDAPPolicyMappingProviderStatus) DeepCopy() *LDAPPolicyMappingProviderStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NotificationConfigurationProviderStatus)
+	out := new(LDAPPolicyMappingProviderStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NotificationConfigurationSpec) DeepCopyInto(out *NotificationConfigurationSpec) {
+func (in *LDAPPolicyMappingSpec) DeepCopyInto(out *LDAPPolicyMappingSpec) {
 	*out = *in
 	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationConfigurationSpec.
-func (in *NotificationConfigurationSpec) DeepCopy() *NotificationConfigurationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPPolicyMappingSpec.
+func (in *LDAPPolicyMappingSpec) DeepCopy() *LDAPPolicyMappingSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NotificationConfigurationSpec)
+	out := new(LDAPPolicyMappingSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NotificationConfigurationStatus) DeepCopyInto(out *NotificationConfigurationStatus) {
+func (in *LDAPPolicyMappingStatus) DeepCopyInto(out *LDAPPolicyMappingStatus) {
 	*out = *in
 	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationConfigurationStatus.
-func (in *NotificationConfigurationStatus) DeepCopy() *NotificationConfigurationStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(NotificationConfigurationStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NotificationFilter) DeepCopyInto(out *NotificationFilter) {
-	*out = *in
-	if in.Key != nil {
-		in, out := &in.Key, &out.Key
-		*out = new(KeyFilter)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationFilter.
-func (in *NotificationFilter) DeepCopy() *NotificationFilter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPPolicyMappingStatus.
+func (in *LDAPPolicyMappingStatus) DeepCopy() *LDAPPolicyMappingStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NotificationFilter)
+	out := new(LDAPPolicyMappingStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Policy) DeepCopyInto(out *Policy) {
+func (in *LDAPProvider) DeepCopyInto(out *LDAPProvider) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -360,18 +385,18 @@ func (in *Policy) DeepCopyInto(out *Policy) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Policy.
-func (in *Policy) DeepCopy() *Policy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPProvider.
+func (in *LDAPProvider) DeepCopy() *LDAPProvider {
 	if in == nil {
 		return nil
 	}
-	out := new(Policy)
+	out := new(LDAPProvider)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Policy) DeepCopyObject() runtime.Object {
+func (in *LDAPProvider) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -379,31 +404,31 @@ func (in *Policy) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyList) DeepCopyInto(out *PolicyList) {
+func (in *LDAPProviderList) DeepCopyInto(out *LDAPProviderList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Policy, len(*in))
+		*out = make([]LDAPProvider, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyList.
-func (in *PolicyList) DeepCopy() *PolicyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPProviderList.
+func (in *LDAPProviderList) DeepCopy() *LDAPProviderList {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyList)
+	out := new(LDAPProviderList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PolicyList) DeepCopyObject() runtime.Object {
+func (in *LDAPProviderList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -411,86 +436,88 @@ func (in *PolicyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyParameters) DeepCopyInto(out *PolicyParameters) {
+func (in *LDAPProviderParameters) DeepCopyInto(out *LDAPProviderParameters) {
 	*out = *in
+	if in.LookupBindPasswordSecretRef != nil {
+		in, out := &in.LookupBindPasswordSecretRef, &out.LookupBindPasswordSecretRef
+		*out = new(v2.LocalSecretKeySelector)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(common.TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyParameters.
-func (in *PolicyParameters) DeepCopy() *PolicyParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPProviderParameters.
+func (in *LDAPProviderParameters) DeepCopy() *LDAPProviderParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyParameters)
+	out := new(LDAPProviderParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyProviderStatus) DeepCopyInto(out *PolicyProviderStatus) {
+func (in *LDAPProviderProviderStatus) DeepCopyInto(out *LDAPProviderProviderStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyProviderStatus.
-func (in *PolicyProviderStatus) DeepCopy() *PolicyProviderStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPProviderProviderStatus.
+func (in *LDAPProviderProviderStatus) DeepCopy() *LDAPProviderProviderStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyProviderStatus)
+	out := new(LDAPProviderProviderStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicySpec) DeepCopyInto(out *PolicySpec) {
+func (in *LDAPProviderSpec) DeepCopyInto(out *LDAPProviderSpec) {
 	*out = *in
 	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
-	out.ForProvider = in.ForProvider
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySpec.
-func (in *PolicySpec) DeepCopy() *PolicySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPProviderSpec.
+func (in *LDAPProviderSpec) DeepCopy() *LDAPProviderSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicySpec)
+	out := new(LDAPProviderSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
+func (in *LDAPProviderStatus) DeepCopyInto(out *LDAPProviderStatus) {
 	*out = *in
 	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
 	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyStatus.
-func (in *PolicyStatus) DeepCopy() *PolicyStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(PolicyStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QueueConfiguration) DeepCopyInto(out *QueueConfiguration) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueConfiguration.
-func (in *QueueConfiguration) DeepCopy() *QueueConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPProviderStatus.
+func (in *LDAPProviderStatus) DeepCopy() *LDAPProviderStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(QueueConfiguration)
+	out := new(LDAPProviderStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceAccount) DeepCopyInto(out *ServiceAccount) {
+func (in *LogTarget) DeepCopyInto(out *LogTarget) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -498,18 +525,18 @@ func (in *ServiceAccount) DeepCopyInto(out *ServiceAccount) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccount.
-func (in *ServiceAccount) DeepCopy() *ServiceAccount {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogTarget.
+func (in *LogTarget) DeepCopy() *LogTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceAccount)
+	out := new(LogTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ServiceAccount) DeepCopyObject() runtime.Object {
+func (in *LogTarget) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -517,31 +544,31 @@ func (in *ServiceAccount) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceAccountList) DeepCopyInto(out *ServiceAccountList) {
+func (in *LogTargetList) DeepCopyInto(out *LogTargetList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ServiceAccount, len(*in))
+		*out = make([]LogTarget, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountList.
-func (in *ServiceAccountList) DeepCopy() *ServiceAccountList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogTargetList.
+func (in *LogTargetList) DeepCopy() *LogTargetList {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceAccountList)
+	out := new(LogTargetList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ServiceAccountList) DeepCopyObject() runtime.Object {
+func (in *LogTargetList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -549,99 +576,107 @@ func (in *ServiceAccountList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceAccountParameters) DeepCopyInto(out *ServiceAccountParameters) {
+func (in *LogTargetParameters) DeepCopyInto(out *LogTargetParameters) {
 	*out = *in
-	if in.Expiration != nil {
-		in, out := &in.Expiration, &out.Expiration
-		*out = (*in).DeepCopy()
+	if in.AuthTokenSecretRef != nil {
+		in, out := &in.AuthTokenSecretRef, &out.AuthTokenSecretRef
+		*out = new(v2.LocalSecretKeySelector)
+		**out = **in
 	}
-	if in.WriteConnectionSecretsToRef != nil {
-		in, out := &in.WriteConnectionSecretsToRef, &out.WriteConnectionSecretsToRef
-		*out = new(v2.SecretReference)
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.QueueSize != nil {
+		in, out := &in.QueueSize, &out.QueueSize
+		*out = new(int64)
 		**out = **in
 	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecretConfig != nil {
+		in, out := &in.SecretConfig, &out.SecretConfig
+		*out = make(map[string]v2.LocalSecretKeySelector, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountParameters.
-func (in *ServiceAccountParameters) DeepCopy() *ServiceAccountParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogTargetParameters.
+func (in *LogTargetParameters) DeepCopy() *LogTargetParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceAccountParameters)
+	out := new(LogTargetParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceAccountProviderStatus) DeepCopyInto(out *ServiceAccountProviderStatus) {
+func (in *LogTargetProviderStatus) DeepCopyInto(out *LogTargetProviderStatus) {
 	*out = *in
-	if in.Expiration != nil {
-		in, out := &in.Expiration, &out.Expiration
-		*out = (*in).DeepCopy()
+	if in.ObservedSecretVersions != nil {
+		in, out := &in.ObservedSecretVersions, &out.ObservedSecretVersions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountProviderStatus.
-func (in *ServiceAccountProviderStatus) DeepCopy() *ServiceAccountProviderStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogTargetProviderStatus.
+func (in *LogTargetProviderStatus) DeepCopy() *LogTargetProviderStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceAccountProviderStatus)
+	out := new(LogTargetProviderStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceAccountSpec) DeepCopyInto(out *ServiceAccountSpec) {
+func (in *LogTargetSpec) DeepCopyInto(out *LogTargetSpec) {
 	*out = *in
 	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountSpec.
-func (in *ServiceAccountSpec) DeepCopy() *ServiceAccountSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogTargetSpec.
+func (in *LogTargetSpec) DeepCopy() *LogTargetSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceAccountSpec)
+	out := new(LogTargetSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceAccountStatus) DeepCopyInto(out *ServiceAccountStatus) {
+func (in *LogTargetStatus) DeepCopyInto(out *LogTargetStatus) {
 	*out = *in
 	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountStatus.
-func (in *ServiceAccountStatus) DeepCopy() *ServiceAccountStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(ServiceAccountStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TopicConfiguration) DeepCopyInto(out *TopicConfiguration) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicConfiguration.
-func (in *TopicConfiguration) DeepCopy() *TopicConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogTargetStatus.
+func (in *LogTargetStatus) DeepCopy() *LogTargetStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(TopicConfiguration)
+	out := new(LogTargetStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *User) DeepCopyInto(out *User) {
+func (in *NotificationConfiguration) DeepCopyInto(out *NotificationConfiguration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -649,18 +684,18 @@ func (in *User) DeepCopyInto(out *User) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new User.
-func (in *User) DeepCopy() *User {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationConfiguration.
+func (in *NotificationConfiguration) DeepCopy() *NotificationConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(User)
+	out := new(NotificationConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *User) DeepCopyObject() runtime.Object {
+func (in *NotificationConfiguration) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -668,31 +703,1171 @@ func (in *User) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *UserList) DeepCopyInto(out *UserList) {
+func (in *NotificationConfigurationList) DeepCopyInto(out *NotificationConfigurationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]User, len(*in))
+		*out = make([]NotificationConfiguration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserList.
-func (in *UserList) DeepCopy() *UserList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationConfigurationList.
+func (in *NotificationConfigurationList) DeepCopy() *NotificationConfigurationList {
 	if in == nil {
 		return nil
 	}
-	out := new(UserList)
+	out := new(NotificationConfigurationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *UserList) DeepCopyObject() runtime.Object {
+func (in *NotificationConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationConfigurationParameters) DeepCopyInto(out *NotificationConfigurationParameters) {
+	*out = *in
+	if in.WebhookConfiguration != nil {
+		in, out := &in.WebhookConfiguration, &out.WebhookConfiguration
+		*out = new(WebhookConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueueConfiguration != nil {
+		in, out := &in.QueueConfiguration, &out.QueueConfiguration
+		*out = new(QueueConfiguration)
+		**out = **in
+	}
+	if in.TopicConfiguration != nil {
+		in, out := &in.TopicConfiguration, &out.TopicConfiguration
+		*out = new(TopicConfiguration)
+		**out = **in
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(NotificationFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]NotificationRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationConfigurationParameters.
+func (in *NotificationConfigurationParameters) DeepCopy() *NotificationConfigurationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationConfigurationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationConfigurationProviderStatus) DeepCopyInto(out *NotificationConfigurationProviderStatus) {
+	*out = *in
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationConfigurationProviderStatus.
+func (in *NotificationConfigurationProviderStatus) DeepCopy() *NotificationConfigurationProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationConfigurationProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationConfigurationSpec) DeepCopyInto(out *NotificationConfigurationSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationConfigurationSpec.
+func (in *NotificationConfigurationSpec) DeepCopy() *NotificationConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationConfigurationStatus) DeepCopyInto(out *NotificationConfigurationStatus) {
+	*out = *in
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationConfigurationStatus.
+func (in *NotificationConfigurationStatus) DeepCopy() *NotificationConfigurationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationConfigurationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationFilter) DeepCopyInto(out *NotificationFilter) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(KeyFilter)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationFilter.
+func (in *NotificationFilter) DeepCopy() *NotificationFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationRule) DeepCopyInto(out *NotificationRule) {
+	*out = *in
+	if in.WebhookConfiguration != nil {
+		in, out := &in.WebhookConfiguration, &out.WebhookConfiguration
+		*out = new(WebhookConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(NotificationFilter)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationRule.
+func (in *NotificationRule) DeepCopy() *NotificationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationTarget) DeepCopyInto(out *NotificationTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationTarget.
+func (in *NotificationTarget) DeepCopy() *NotificationTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationTargetList) DeepCopyInto(out *NotificationTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NotificationTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationTargetList.
+func (in *NotificationTargetList) DeepCopy() *NotificationTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationTargetParameters) DeepCopyInto(out *NotificationTargetParameters) {
+	*out = *in
+	if in.AuthTokenSecretRef != nil {
+		in, out := &in.AuthTokenSecretRef, &out.AuthTokenSecretRef
+		*out = new(v2.LocalSecretKeySelector)
+		**out = **in
+	}
+	if in.QueueLimit != nil {
+		in, out := &in.QueueLimit, &out.QueueLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecretConfig != nil {
+		in, out := &in.SecretConfig, &out.SecretConfig
+		*out = make(map[string]v2.LocalSecretKeySelector, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationTargetParameters.
+func (in *NotificationTargetParameters) DeepCopy() *NotificationTargetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationTargetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationTargetProviderStatus) DeepCopyInto(out *NotificationTargetProviderStatus) {
+	*out = *in
+	if in.ObservedSecretVersions != nil {
+		in, out := &in.ObservedSecretVersions, &out.ObservedSecretVersions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationTargetProviderStatus.
+func (in *NotificationTargetProviderStatus) DeepCopy() *NotificationTargetProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationTargetProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationTargetSpec) DeepCopyInto(out *NotificationTargetSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationTargetSpec.
+func (in *NotificationTargetSpec) DeepCopy() *NotificationTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationTargetStatus) DeepCopyInto(out *NotificationTargetStatus) {
+	*out = *in
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationTargetStatus.
+func (in *NotificationTargetStatus) DeepCopy() *NotificationTargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationTargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenIDProvider) DeepCopyInto(out *OpenIDProvider) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDProvider.
+func (in *OpenIDProvider) DeepCopy() *OpenIDProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenIDProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenIDProvider) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenIDProviderList) DeepCopyInto(out *OpenIDProviderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenIDProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDProviderList.
+func (in *OpenIDProviderList) DeepCopy() *OpenIDProviderList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenIDProviderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenIDProviderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenIDProviderParameters) DeepCopyInto(out *OpenIDProviderParameters) {
+	*out = *in
+	if in.ClientSecretSecretRef != nil {
+		in, out := &in.ClientSecretSecretRef, &out.ClientSecretSecretRef
+		*out = new(v2.LocalSecretKeySelector)
+		**out = **in
+	}
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDProviderParameters.
+func (in *OpenIDProviderParameters) DeepCopy() *OpenIDProviderParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenIDProviderParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenIDProviderProviderStatus) DeepCopyInto(out *OpenIDProviderProviderStatus) {
+	*out = *in
+	if in.ReachabilityCheckedAt != nil {
+		in, out := &in.ReachabilityCheckedAt, &out.ReachabilityCheckedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDProviderProviderStatus.
+func (in *OpenIDProviderProviderStatus) DeepCopy() *OpenIDProviderProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenIDProviderProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenIDProviderSpec) DeepCopyInto(out *OpenIDProviderSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDProviderSpec.
+func (in *OpenIDProviderSpec) DeepCopy() *OpenIDProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenIDProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenIDProviderStatus) DeepCopyInto(out *OpenIDProviderStatus) {
+	*out = *in
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDProviderStatus.
+func (in *OpenIDProviderStatus) DeepCopy() *OpenIDProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenIDProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Policy) DeepCopyInto(out *Policy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Policy.
+func (in *Policy) DeepCopy() *Policy {
+	if in == nil {
+		return nil
+	}
+	out := new(Policy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Policy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyList) DeepCopyInto(out *PolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Policy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyList.
+func (in *PolicyList) DeepCopy() *PolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyParameters) DeepCopyInto(out *PolicyParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyParameters.
+func (in *PolicyParameters) DeepCopy() *PolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyProviderStatus) DeepCopyInto(out *PolicyProviderStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyProviderStatus.
+func (in *PolicyProviderStatus) DeepCopy() *PolicyProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySpec) DeepCopyInto(out *PolicySpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySpec.
+func (in *PolicySpec) DeepCopy() *PolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
+	*out = *in
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyStatus.
+func (in *PolicyStatus) DeepCopy() *PolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueConfiguration) DeepCopyInto(out *QueueConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueConfiguration.
+func (in *QueueConfiguration) DeepCopy() *QueueConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretRotation) DeepCopyInto(out *SecretRotation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretRotation.
+func (in *SecretRotation) DeepCopy() *SecretRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerConfig) DeepCopyInto(out *ServerConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerConfig.
+func (in *ServerConfig) DeepCopy() *ServerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServerConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerConfigList) DeepCopyInto(out *ServerConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServerConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerConfigList.
+func (in *ServerConfigList) DeepCopy() *ServerConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServerConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerConfigParameters) DeepCopyInto(out *ServerConfigParameters) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecretConfig != nil {
+		in, out := &in.SecretConfig, &out.SecretConfig
+		*out = make(map[string]v2.LocalSecretKeySelector, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerConfigParameters.
+func (in *ServerConfigParameters) DeepCopy() *ServerConfigParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerConfigParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerConfigProviderStatus) DeepCopyInto(out *ServerConfigProviderStatus) {
+	*out = *in
+	if in.EnvOverriddenKeys != nil {
+		in, out := &in.EnvOverriddenKeys, &out.EnvOverriddenKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ObservedSecretVersions != nil {
+		in, out := &in.ObservedSecretVersions, &out.ObservedSecretVersions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerConfigProviderStatus.
+func (in *ServerConfigProviderStatus) DeepCopy() *ServerConfigProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerConfigProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerConfigSpec) DeepCopyInto(out *ServerConfigSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerConfigSpec.
+func (in *ServerConfigSpec) DeepCopy() *ServerConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerConfigStatus) DeepCopyInto(out *ServerConfigStatus) {
+	*out = *in
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerConfigStatus.
+func (in *ServerConfigStatus) DeepCopy() *ServerConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccount) DeepCopyInto(out *ServiceAccount) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccount.
+func (in *ServiceAccount) DeepCopy() *ServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceAccount) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountList) DeepCopyInto(out *ServiceAccountList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountList.
+func (in *ServiceAccountList) DeepCopy() *ServiceAccountList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceAccountList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountParameters) DeepCopyInto(out *ServiceAccountParameters) {
+	*out = *in
+	if in.SecretKeySecretRef != nil {
+		in, out := &in.SecretKeySecretRef, &out.SecretKeySecretRef
+		*out = new(v2.LocalSecretKeySelector)
+		**out = **in
+	}
+	if in.PolicyRefs != nil {
+		in, out := &in.PolicyRefs, &out.PolicyRefs
+		*out = make([]v2.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CannedPolicies != nil {
+		in, out := &in.CannedPolicies, &out.CannedPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Expiration != nil {
+		in, out := &in.Expiration, &out.Expiration
+		*out = (*in).DeepCopy()
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	out.ExpiryWarningWindow = in.ExpiryWarningWindow
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(ServiceAccountRotation)
+		**out = **in
+	}
+	if in.WriteConnectionSecretsToRef != nil {
+		in, out := &in.WriteConnectionSecretsToRef, &out.WriteConnectionSecretsToRef
+		*out = new(v2.SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountParameters.
+func (in *ServiceAccountParameters) DeepCopy() *ServiceAccountParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountProviderStatus) DeepCopyInto(out *ServiceAccountProviderStatus) {
+	*out = *in
+	if in.Expiration != nil {
+		in, out := &in.Expiration, &out.Expiration
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRotation != nil {
+		in, out := &in.LastRotation, &out.LastRotation
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRotation != nil {
+		in, out := &in.NextRotation, &out.NextRotation
+		*out = (*in).DeepCopy()
+	}
+	if in.PreviousAccessKeyDeletion != nil {
+		in, out := &in.PreviousAccessKeyDeletion, &out.PreviousAccessKeyDeletion
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountProviderStatus.
+func (in *ServiceAccountProviderStatus) DeepCopy() *ServiceAccountProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountRotation) DeepCopyInto(out *ServiceAccountRotation) {
+	*out = *in
+	out.OverlapPeriod = in.OverlapPeriod
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountRotation.
+func (in *ServiceAccountRotation) DeepCopy() *ServiceAccountRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountSpec) DeepCopyInto(out *ServiceAccountSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountSpec.
+func (in *ServiceAccountSpec) DeepCopy() *ServiceAccountSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountStatus) DeepCopyInto(out *ServiceAccountStatus) {
+	*out = *in
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountStatus.
+func (in *ServiceAccountStatus) DeepCopy() *ServiceAccountStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tier) DeepCopyInto(out *Tier) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tier.
+func (in *Tier) DeepCopy() *Tier {
+	if in == nil {
+		return nil
+	}
+	out := new(Tier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Tier) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TierList) DeepCopyInto(out *TierList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Tier, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TierList.
+func (in *TierList) DeepCopy() *TierList {
+	if in == nil {
+		return nil
+	}
+	out := new(TierList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TierList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TierParameters) DeepCopyInto(out *TierParameters) {
+	*out = *in
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TierParameters.
+func (in *TierParameters) DeepCopy() *TierParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TierParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TierProviderStatus) DeepCopyInto(out *TierProviderStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TierProviderStatus.
+func (in *TierProviderStatus) DeepCopy() *TierProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TierProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TierSpec) DeepCopyInto(out *TierSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TierSpec.
+func (in *TierSpec) DeepCopy() *TierSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TierSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TierStatus) DeepCopyInto(out *TierStatus) {
+	*out = *in
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TierStatus.
+func (in *TierStatus) DeepCopy() *TierStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TierStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopicConfiguration) DeepCopyInto(out *TopicConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicConfiguration.
+func (in *TopicConfiguration) DeepCopy() *TopicConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(TopicConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *User) DeepCopyInto(out *User) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new User.
+func (in *User) DeepCopy() *User {
+	if in == nil {
+		return nil
+	}
+	out := new(User)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *User) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserList) DeepCopyInto(out *UserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]User, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserList.
+func (in *UserList) DeepCopy() *UserList {
+	if in == nil {
+		return nil
+	}
+	out := new(UserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -707,6 +1882,30 @@ func (in *UserParameters) DeepCopyInto(out *UserParameters) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = new([]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.SecretKeySecretRef != nil {
+		in, out := &in.SecretKeySecretRef, &out.SecretKeySecretRef
+		*out = new(v2.LocalSecretKeySelector)
+		**out = **in
+	}
+	if in.CredentialVerification != nil {
+		in, out := &in.CredentialVerification, &out.CredentialVerification
+		*out = new(CredentialVerification)
+		**out = **in
+	}
+	if in.SecretRotation != nil {
+		in, out := &in.SecretRotation, &out.SecretRotation
+		*out = new(SecretRotation)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserParameters.
@@ -722,6 +1921,28 @@ func (in *UserParameters) DeepCopy() *UserParameters {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserProviderStatus) DeepCopyInto(out *UserProviderStatus) {
 	*out = *in
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastSecretRotation != nil {
+		in, out := &in.LastSecretRotation, &out.LastSecretRotation
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCredentialVerification != nil {
+		in, out := &in.LastCredentialVerification, &out.LastCredentialVerification
+		*out = (*in).DeepCopy()
+	}
+	if in.CredentialsUpdatedAt != nil {
+		in, out := &in.CredentialsUpdatedAt, &out.CredentialsUpdatedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserProviderStatus.
@@ -755,7 +1976,7 @@ func (in *UserSpec) DeepCopy() *UserSpec {
 func (in *UserStatus) DeepCopyInto(out *UserStatus) {
 	*out = *in
 	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserStatus.
@@ -14,6 +14,7 @@ import (
 	providerv1 "github.com/rossigee/provider-minio/apis/provider/v1"
 	"github.com/rossigee/provider-minio/internal/clients"
 	"github.com/rossigee/provider-minio/internal/tracing"
+	"github.com/rossigee/provider-minio/operator/minioutil"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -123,15 +124,12 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	// User exists, update status
 	cr.Status.AtProvider.UserName = userName
 	cr.Status.AtProvider.Status = string(userInfo.Status)
-	cr.Status.AtProvider.Policies = userInfo.PolicyName
 
 	// Check if policies match desired state
 	desiredPolicies := cr.Spec.ForProvider.Policies
-	// PolicyName is a single string, convert to slice for comparison
-	var currentPolicies []string
-	if userInfo.PolicyName != "" {
-		currentPolicies = []string{userInfo.PolicyName}
-	}
+	// PolicyName is a comma separated list, convert to slice for comparison
+	currentPolicies := minioutil.ParsePolicies(userInfo.PolicyName)
+	cr.Status.AtProvider.Policies = currentPolicies
 
 	policiesMatch := len(desiredPolicies) == len(currentPolicies)
 	if policiesMatch {
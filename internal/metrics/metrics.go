@@ -0,0 +1,184 @@
+// Package metrics exposes Prometheus metrics for the MinIO API calls made by
+// the provider and for the state of the managed resources it reconciles.
+package metrics
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+	"github.com/prometheus/client_golang/prometheus"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	namespace = "provider_minio"
+
+	operationLabel      = "operation"
+	kindLabel           = "kind"
+	providerConfigLabel = "provider_config"
+	codeLabel           = "code"
+
+	adminPathPrefix = "/minio/admin/"
+
+	stateRecordInterval = 1 * time.Minute
+)
+
+var (
+	apiRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "api",
+		Name:      "requests_total",
+		Help:      "Total number of MinIO API calls made by the provider.",
+	}, []string{operationLabel, kindLabel, providerConfigLabel, codeLabel})
+
+	apiErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "api",
+		Name:      "errors_total",
+		Help:      "Total number of MinIO API calls that failed or returned an error status.",
+	}, []string{operationLabel, kindLabel, providerConfigLabel})
+
+	apiDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "api",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of MinIO API calls made by the provider.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{operationLabel, kindLabel, providerConfigLabel})
+
+	// MRState holds the gauges for managed resource counts by readiness.
+	// It is shared by the state recorders of all controllers.
+	MRState = statemetrics.NewMRStateMetrics()
+)
+
+func init() {
+	metrics.Registry.MustRegister(apiRequests, apiErrors, apiDuration, MRState)
+}
+
+// NewTransport returns a http.RoundTripper that records the calls going
+// through next, labelled with the given managed resource kind and ProviderConfig.
+func NewTransport(next http.RoundTripper, kind, providerConfig string) http.RoundTripper {
+	return &transport{
+		next:           next,
+		kind:           kind,
+		providerConfig: providerConfig,
+	}
+}
+
+type transport struct {
+	next           http.RoundTripper
+	kind           string
+	providerConfig string
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	operation := Operation(req)
+	start := time.Now()
+
+	resp, err := t.next.RoundTrip(req)
+
+	apiDuration.WithLabelValues(operation, t.kind, t.providerConfig).Observe(time.Since(start).Seconds())
+
+	code := "error"
+	if resp != nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+	apiRequests.WithLabelValues(operation, t.kind, t.providerConfig, code).Inc()
+
+	if err != nil || resp.StatusCode >= http.StatusBadRequest {
+		apiErrors.WithLabelValues(operation, t.kind, t.providerConfig).Inc()
+	}
+
+	return resp, err
+}
+
+// s3SubResources are the S3 sub-resources that name an operation, e.g. `?notification`.
+var s3SubResources = map[string]bool{
+	"accelerate":        true,
+	"acl":               true,
+	"attributes":        true,
+	"cors":              true,
+	"delete":            true,
+	"encryption":        true,
+	"legal-hold":        true,
+	"lifecycle":         true,
+	"location":          true,
+	"logging":           true,
+	"notification":      true,
+	"object-lock":       true,
+	"ownershipControls": true,
+	"policy":            true,
+	"policyStatus":      true,
+	"publicAccessBlock": true,
+	"replication":       true,
+	"requestPayment":    true,
+	"restore":           true,
+	"retention":         true,
+	"select":            true,
+	"tagging":           true,
+	"uploads":           true,
+	"versioning":        true,
+	"versions":          true,
+	"website":           true,
+}
+
+// Operation derives a low-cardinality operation name from a MinIO API request.
+// Admin API calls are named after their endpoint (e.g. `list-users`), S3 calls
+// after the HTTP method and the addressed sub-resource (e.g. `get-notification`).
+func Operation(req *http.Request) string {
+	path := req.URL.Path
+
+	if strings.HasPrefix(path, adminPathPrefix) {
+		// /minio/admin/<version>/<operation>[/...]
+		parts := strings.Split(strings.TrimPrefix(path, adminPathPrefix), "/")
+		if len(parts) > 1 && parts[1] != "" {
+			return parts[1]
+		}
+		return "admin"
+	}
+
+	method := strings.ToLower(req.Method)
+
+	// Sub-resources are query parameters without a value, e.g. `?tagging`.
+	// Other empty parameters, like the `prefix` of ListObjects, do not name the operation.
+	query := req.URL.Query()
+	keys := make([]string, 0, len(query))
+	for key, values := range query {
+		if !s3SubResources[key] {
+			continue
+		}
+		if len(values) == 0 || (len(values) == 1 && values[0] == "") {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) > 0 {
+		sort.Strings(keys)
+		return method + "-" + keys[0]
+	}
+
+	switch strings.Count(strings.Trim(path, "/"), "/") {
+	case 0:
+		if strings.Trim(path, "/") == "" {
+			return method + "-service"
+		}
+		return method + "-bucket"
+	default:
+		return method + "-object"
+	}
+}
+
+// NewStateRecorder returns a manager runnable that periodically records the
+// number of existing, ready and synced managed resources of the given list type.
+func NewStateRecorder(mgr ctrl.Manager, name string, list resource.ManagedList) manager.Runnable {
+	log := logging.NewLogrLogger(mgr.GetLogger().WithValues("controller", name))
+	return statemetrics.NewMRStateRecorder(mgr.GetClient(), log, MRState, list, stateRecordInterval)
+}
@@ -0,0 +1,102 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperation(t *testing.T) {
+	tests := map[string]struct {
+		method string
+		url    string
+		want   string
+	}{
+		"AdminCall": {
+			method: http.MethodGet,
+			url:    "https://minio:9000/minio/admin/v3/list-users",
+			want:   "list-users",
+		},
+		"AdminCallWithQuery": {
+			method: http.MethodPut,
+			url:    "https://minio:9000/minio/admin/v3/add-user?accessKey=foo",
+			want:   "add-user",
+		},
+		"ListBuckets": {
+			method: http.MethodGet,
+			url:    "https://minio:9000/",
+			want:   "get-service",
+		},
+		"MakeBucket": {
+			method: http.MethodPut,
+			url:    "https://minio:9000/my-bucket/",
+			want:   "put-bucket",
+		},
+		"BucketSubResource": {
+			method: http.MethodPut,
+			url:    "https://minio:9000/my-bucket/?notification=",
+			want:   "put-notification",
+		},
+		"ListObjects": {
+			method: http.MethodGet,
+			url:    "https://minio:9000/my-bucket/?delimiter=&encoding-type=url&fetch-owner=true&list-type=2&prefix=",
+			want:   "get-bucket",
+		},
+		"ListObjectVersions": {
+			method: http.MethodGet,
+			url:    "https://minio:9000/my-bucket/?delimiter=&prefix=&versions=",
+			want:   "get-versions",
+		},
+		"Object": {
+			method: http.MethodDelete,
+			url:    "https://minio:9000/my-bucket/path/to/object",
+			want:   "delete-object",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.url, nil)
+			assert.Equal(t, tc.want, Operation(req))
+		})
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestTransport_RoundTrip(t *testing.T) {
+	const kind, pc = "TestKind", "test-config"
+
+	ok := NewTransport(roundTripFunc(func(*http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}), kind, pc)
+	denied := NewTransport(roundTripFunc(func(*http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusForbidden}, nil
+	}), kind, pc)
+	broken := NewTransport(roundTripFunc(func(*http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	}), kind, pc)
+
+	req := httptest.NewRequest(http.MethodGet, "https://minio:9000/minio/admin/v3/info-canned-policy", nil)
+
+	_, err := ok.RoundTrip(req)
+	require.NoError(t, err)
+	_, err = denied.RoundTrip(req)
+	require.NoError(t, err)
+	_, err = broken.RoundTrip(req)
+	require.Error(t, err)
+
+	op := "info-canned-policy"
+	assert.Equal(t, 1.0, testutil.ToFloat64(apiRequests.WithLabelValues(op, kind, pc, "200")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(apiRequests.WithLabelValues(op, kind, pc, "403")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(apiRequests.WithLabelValues(op, kind, pc, "error")))
+	assert.Equal(t, 2.0, testutil.ToFloat64(apiErrors.WithLabelValues(op, kind, pc)))
+}
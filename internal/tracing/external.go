@@ -0,0 +1,106 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	operationObserve = "observe"
+	operationCreate  = "create"
+	operationUpdate  = "update"
+	operationDelete  = "delete"
+)
+
+// NewExternalConnector wraps c so that every ExternalClient it produces starts
+// a span around Observe, Create, Update and Delete.
+// The span context is passed down to the client, making the MinIO API calls
+// made with it child spans.
+func NewExternalConnector(kind string, c managed.ExternalConnector) managed.ExternalConnector {
+	return &externalConnector{kind: kind, connector: c}
+}
+
+type externalConnector struct {
+	kind      string
+	connector managed.ExternalConnector
+}
+
+// Connect implements managed.ExternalConnector.
+func (c *externalConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.connector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &externalClient{kind: c.kind, client: ec}, nil
+}
+
+type externalClient struct {
+	kind   string
+	client managed.ExternalClient
+}
+
+// Observe implements managed.ExternalClient.
+func (c *externalClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	ctx, span := c.start(ctx, mg, operationObserve)
+	defer span.End()
+
+	obs, err := c.client.Observe(ctx, mg)
+	if err == nil {
+		span.SetAttributes(
+			attribute.Bool("crossplane.resource.exists", obs.ResourceExists),
+			attribute.Bool("crossplane.resource.up_to_date", obs.ResourceUpToDate),
+		)
+	}
+	return obs, recordError(span, err)
+}
+
+// Create implements managed.ExternalClient.
+func (c *externalClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	ctx, span := c.start(ctx, mg, operationCreate)
+	defer span.End()
+
+	creation, err := c.client.Create(ctx, mg)
+	return creation, recordError(span, err)
+}
+
+// Update implements managed.ExternalClient.
+func (c *externalClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	ctx, span := c.start(ctx, mg, operationUpdate)
+	defer span.End()
+
+	update, err := c.client.Update(ctx, mg)
+	return update, recordError(span, err)
+}
+
+// Delete implements managed.ExternalClient.
+func (c *externalClient) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	ctx, span := c.start(ctx, mg, operationDelete)
+	defer span.End()
+
+	deletion, err := c.client.Delete(ctx, mg)
+	return deletion, recordError(span, err)
+}
+
+// Disconnect implements managed.ExternalClient.
+func (c *externalClient) Disconnect(ctx context.Context) error {
+	return c.client.Disconnect(ctx)
+}
+
+func (c *externalClient) start(ctx context.Context, mg resource.Managed, operation string) (context.Context, trace.Span) {
+	attrs := append(SpanAttrs(c.kind, mg.GetName(), operation), attribute.String(resourceNSAttr, mg.GetNamespace()))
+	return StartSpan(ctx, strings.ToLower(c.kind)+"."+operation, attrs...)
+}
+
+func recordError(span trace.Span, err error) error {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,118 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeExternalClient struct {
+	err  error
+	span trace.SpanContext
+}
+
+func (c *fakeExternalClient) Observe(ctx context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+	c.span = trace.SpanContextFromContext(ctx)
+	return managed.ExternalObservation{ResourceExists: true}, c.err
+}
+
+func (c *fakeExternalClient) Create(ctx context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+	c.span = trace.SpanContextFromContext(ctx)
+	return managed.ExternalCreation{}, c.err
+}
+
+func (c *fakeExternalClient) Update(ctx context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	c.span = trace.SpanContextFromContext(ctx)
+	return managed.ExternalUpdate{}, c.err
+}
+
+func (c *fakeExternalClient) Delete(ctx context.Context, _ resource.Managed) (managed.ExternalDelete, error) {
+	c.span = trace.SpanContextFromContext(ctx)
+	return managed.ExternalDelete{}, c.err
+}
+
+func (c *fakeExternalClient) Disconnect(context.Context) error {
+	return nil
+}
+
+func TestExternalClient(t *testing.T) {
+	operations := map[string]func(context.Context, managed.ExternalClient, resource.Managed) error{
+		operationObserve: func(ctx context.Context, c managed.ExternalClient, mg resource.Managed) error {
+			_, err := c.Observe(ctx, mg)
+			return err
+		},
+		operationCreate: func(ctx context.Context, c managed.ExternalClient, mg resource.Managed) error {
+			_, err := c.Create(ctx, mg)
+			return err
+		},
+		operationUpdate: func(ctx context.Context, c managed.ExternalClient, mg resource.Managed) error {
+			_, err := c.Update(ctx, mg)
+			return err
+		},
+		operationDelete: func(ctx context.Context, c managed.ExternalClient, mg resource.Managed) error {
+			_, err := c.Delete(ctx, mg)
+			return err
+		},
+	}
+	tests := map[string]struct {
+		givenErr error
+	}{
+		"GivenSuccess_ThenEndSpan": {},
+		"GivenError_ThenRecordErrorOnSpan": {
+			givenErr: errors.New("boom"),
+		},
+	}
+	for name, tc := range tests {
+		for operation, call := range operations {
+			t.Run(name+"/"+operation, func(t *testing.T) {
+				recorder := tracetest.NewSpanRecorder()
+				// The global tracer only delegates to the first TracerProvider set, use a dedicated one per test.
+				previous := tracer
+				tracer = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer(tracerName)
+				t.Cleanup(func() { tracer = previous })
+
+				fake := &fakeExternalClient{err: tc.givenErr}
+				connector := NewExternalConnector("User", managed.ExternalConnectorFn(func(context.Context, resource.Managed) (managed.ExternalClient, error) {
+					return fake, nil
+				}))
+				mg := &miniov1beta1.User{ObjectMeta: metav1.ObjectMeta{Name: "user", Namespace: "default"}}
+
+				client, err := connector.Connect(t.Context(), mg)
+				require.NoError(t, err)
+				err = call(t.Context(), client, mg)
+				assert.Equal(t, tc.givenErr, err)
+
+				assert.Empty(t, recorder.Started()[len(recorder.Ended()):], "every started span must be ended")
+				spans := recorder.Ended()
+				require.Len(t, spans, 1)
+				span := spans[0]
+				assert.Equal(t, "user."+operation, span.Name())
+				assert.Equal(t, span.SpanContext().SpanID(), fake.span.SpanID(), "client must run within the span")
+				assert.Contains(t, span.Attributes(), attribute.String(operationAttr, operation))
+				assert.Contains(t, span.Attributes(), attribute.String(resourceNSAttr, "default"))
+
+				if tc.givenErr == nil {
+					assert.Equal(t, codes.Unset, span.Status().Code)
+					assert.Empty(t, span.Events())
+					return
+				}
+				assert.Equal(t, codes.Error, span.Status().Code)
+				assert.Equal(t, tc.givenErr.Error(), span.Status().Description)
+				require.Len(t, span.Events(), 1)
+				assert.Equal(t, "exception", span.Events()[0].Name)
+			})
+		}
+	}
+}
@@ -20,10 +20,13 @@ const (
 	tracerName       = "provider-minio"
 	resourceTypeAttr = "crossplane.resource.type"
 	resourceNameAttr = "crossplane.resource.name"
+	resourceNSAttr   = "crossplane.resource.namespace"
 	operationAttr    = "crossplane.operation"
 )
 
-var tracer trace.Tracer
+// tracer delegates to the global TracerProvider, so spans started before Init
+// or with tracing disabled are no-ops.
+var tracer = otel.Tracer(tracerName)
 var tp *sdktrace.TracerProvider
 
 func Init(serviceName string) func(context.Context) {
@@ -0,0 +1,52 @@
+package tracing
+
+import (
+	"net/http"
+
+	"github.com/rossigee/provider-minio/internal/metrics"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewTransport returns a http.RoundTripper that starts a client span for every
+// call going through next and injects the trace context into its headers.
+// The injected headers are not part of the request signature, so MinIO accepts
+// them as is.
+func NewTransport(next http.RoundTripper) http.RoundTripper {
+	return &transport{next: next}
+}
+
+type transport struct {
+	next http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := tracer.Start(req.Context(), "minio."+metrics.Operation(req),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", req.Method),
+			attribute.String("server.address", req.URL.Hostname()),
+			attribute.String("url.path", req.URL.Path),
+		),
+	)
+	defer span.End()
+
+	// A RoundTripper must not modify the request it was given.
+	req = req.Clone(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return resp, recordError(span, err)
+	}
+
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+	return resp, nil
+}
@@ -0,0 +1,47 @@
+package tracing
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestTransport_RoundTrip(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	ctx, parent := StartSpan(t.Context(), "user.observe")
+
+	var traceparent string
+	rt := NewTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		traceparent = req.Header.Get("traceparent")
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "https://minio:9000/minio/admin/v3/user-info?accessKey=foo", nil).WithContext(ctx)
+	_, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	parent.End()
+
+	assert.Empty(t, req.Header.Get("traceparent"), "original request must not be modified")
+	assert.Contains(t, traceparent, parent.SpanContext().TraceID().String())
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "minio.user-info", spans[0].Name())
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+}
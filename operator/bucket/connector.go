@@ -58,7 +58,7 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, err
 	}
 
-	mc, err := minioutil.NewMinioClient(ctx, c.kube, config)
+	mc, err := minioutil.NewMinioClient(ctx, c.kube, config, miniov1beta1.BucketKind)
 	if err != nil {
 		return nil, err
 	}
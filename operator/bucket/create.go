@@ -46,6 +46,13 @@ func (b *bucketClient) Create(ctx context.Context, mg resource.Managed) (managed
 		}
 	}
 
+	if rules := bucket.Spec.ForProvider.LifecycleRules; rules != nil && len(*rules) > 0 {
+		err = b.mc.SetBucketLifecycle(ctx, bucket.GetBucketName(), desiredLifecycle(*rules))
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+
 	b.setLock(bucket)
 	return managed.ExternalCreation{}, b.emitCreationEvent(bucket)
 }
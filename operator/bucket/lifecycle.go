@@ -0,0 +1,84 @@
+package bucket
+
+import (
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+)
+
+const (
+	ruleEnabled  = "Enabled"
+	ruleDisabled = "Disabled"
+)
+
+// lifecycleRule is the part of a lifecycle rule that is managed by a Bucket.
+type lifecycleRule struct {
+	prefix         string
+	status         string
+	transitionDays int
+	tierName       string
+	expirationDays int
+}
+
+// desiredLifecycle returns the lifecycle configuration of the given rules.
+// Transition rules reference the tier by its name as storage class.
+func desiredLifecycle(rules []miniov1beta1.BucketLifecycleRule) *lifecycle.Configuration {
+	config := lifecycle.NewConfiguration()
+	for _, rule := range rules {
+		r := lifecycle.Rule{
+			ID:         rule.ID,
+			Status:     ruleEnabled,
+			RuleFilter: lifecycle.Filter{Prefix: rule.Prefix},
+		}
+		if rule.Disabled {
+			r.Status = ruleDisabled
+		}
+		if rule.Transition != nil {
+			r.Transition = lifecycle.Transition{
+				Days:         lifecycle.ExpirationDays(rule.Transition.Days),
+				StorageClass: rule.Transition.TierName,
+			}
+		}
+		if rule.ExpirationDays > 0 {
+			r.Expiration = lifecycle.Expiration{Days: lifecycle.ExpirationDays(rule.ExpirationDays)}
+		}
+		config.Rules = append(config.Rules, r)
+	}
+	return config
+}
+
+// lifecycleRules returns the managed parts of the rules in the lifecycle configuration by ID.
+func lifecycleRules(config *lifecycle.Configuration) map[string]lifecycleRule {
+	rules := map[string]lifecycleRule{}
+	if config == nil {
+		return rules
+	}
+	for _, rule := range config.Rules {
+		prefix := rule.RuleFilter.Prefix
+		if prefix == "" {
+			// Older rules set the deprecated top-level prefix instead of a filter.
+			prefix = rule.Prefix
+		}
+		rules[rule.ID] = lifecycleRule{
+			prefix:         prefix,
+			status:         rule.Status,
+			transitionDays: int(rule.Transition.Days),
+			tierName:       rule.Transition.StorageClass,
+			expirationDays: int(rule.Expiration.Days),
+		}
+	}
+	return rules
+}
+
+// equalLifecycle returns true if the current lifecycle configuration contains exactly the desired rules.
+func equalLifecycle(current, desired *lifecycle.Configuration) bool {
+	currentRules, desiredRules := lifecycleRules(current), lifecycleRules(desired)
+	if len(currentRules) != len(desiredRules) {
+		return false
+	}
+	for id, rule := range desiredRules {
+		if currentRule, ok := currentRules[id]; !ok || currentRule != rule {
+			return false
+		}
+	}
+	return true
+}
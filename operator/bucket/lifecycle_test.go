@@ -0,0 +1,88 @@
+package bucket
+
+import (
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDesiredLifecycle(t *testing.T) {
+	config := desiredLifecycle([]miniov1beta1.BucketLifecycleRule{
+		{ID: "archive", Prefix: "logs/", Transition: &miniov1beta1.BucketLifecycleTransition{Days: 30, TierName: "COLD"}},
+		{ID: "cleanup", Disabled: true, ExpirationDays: 7},
+	})
+
+	assert.Len(t, config.Rules, 2)
+	archive := config.Rules[0]
+	assert.Equal(t, "archive", archive.ID)
+	assert.Equal(t, "Enabled", archive.Status)
+	assert.Equal(t, "logs/", archive.RuleFilter.Prefix)
+	assert.Equal(t, lifecycle.ExpirationDays(30), archive.Transition.Days)
+	assert.Equal(t, "COLD", archive.Transition.StorageClass, "the tier is referenced by name as storage class")
+	assert.True(t, archive.Expiration.IsNull())
+
+	cleanup := config.Rules[1]
+	assert.Equal(t, "Disabled", cleanup.Status)
+	assert.Equal(t, lifecycle.ExpirationDays(7), cleanup.Expiration.Days)
+	assert.True(t, cleanup.Transition.IsNull())
+
+	assert.True(t, desiredLifecycle(nil).Empty())
+}
+
+func TestEqualLifecycle(t *testing.T) {
+	rules := []miniov1beta1.BucketLifecycleRule{
+		{ID: "archive", Prefix: "logs/", Transition: &miniov1beta1.BucketLifecycleTransition{Days: 30, TierName: "COLD"}},
+		{ID: "cleanup", ExpirationDays: 7},
+	}
+	desired := desiredLifecycle(rules)
+
+	tests := map[string]struct {
+		current  func() *lifecycle.Configuration
+		expected bool
+	}{
+		"GivenSameRules_ThenEqual": {
+			current:  func() *lifecycle.Configuration { return desiredLifecycle(rules) },
+			expected: true,
+		},
+		"GivenRulesInOtherOrder_ThenEqual": {
+			current: func() *lifecycle.Configuration {
+				return desiredLifecycle([]miniov1beta1.BucketLifecycleRule{rules[1], rules[0]})
+			},
+			expected: true,
+		},
+		"GivenDeprecatedPrefix_ThenEqual": {
+			current: func() *lifecycle.Configuration {
+				config := desiredLifecycle(rules)
+				config.Rules[0].Prefix = config.Rules[0].RuleFilter.Prefix
+				config.Rules[0].RuleFilter = lifecycle.Filter{}
+				return config
+			},
+			expected: true,
+		},
+		"GivenOtherTier_ThenNotEqual": {
+			current: func() *lifecycle.Configuration {
+				config := desiredLifecycle(rules)
+				config.Rules[0].Transition.StorageClass = "WARM"
+				return config
+			},
+		},
+		"GivenMissingRule_ThenNotEqual": {
+			current: func() *lifecycle.Configuration { return desiredLifecycle(rules[:1]) },
+		},
+		"GivenAdditionalRule_ThenNotEqual": {
+			current: func() *lifecycle.Configuration {
+				return desiredLifecycle(append([]miniov1beta1.BucketLifecycleRule{{ID: "manual", ExpirationDays: 1}}, rules...))
+			},
+		},
+		"GivenNoConfiguration_ThenNotEqual": {
+			current: func() *lifecycle.Configuration { return nil },
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, equalLifecycle(tc.current(), desired))
+		})
+	}
+}
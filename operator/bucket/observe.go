@@ -43,6 +43,18 @@ var bucketTagsLatestFn = func(ctx context.Context, mc *minio.Client, bucketName
 	return reflect.DeepEqual(current.ToMap(), desiredTags), nil
 }
 
+var bucketLifecycleLatestFn = func(ctx context.Context, mc *minio.Client, bucketName string, desiredRules []miniov1beta1.BucketLifecycleRule) (bool, error) {
+	current, err := mc.GetBucketLifecycle(ctx, bucketName)
+	if err != nil {
+		// MinIO returns NoSuchLifecycleConfiguration when no rules are set
+		if minio.ToErrorResponse(err).Code == "NoSuchLifecycleConfiguration" {
+			return len(desiredRules) == 0, nil
+		}
+		return false, err
+	}
+	return equalLifecycle(current, desiredLifecycle(desiredRules)), nil
+}
+
 func (d *bucketClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
 	log := ctrl.LoggerFrom(ctx)
 	log.V(1).Info("observing resource")
@@ -94,6 +106,14 @@ func (d *bucketClient) observeBucket(ctx context.Context, bucket *miniov1beta1.B
 			isLatest = u
 		}
 
+		if isLatest && bucket.Spec.ForProvider.LifecycleRules != nil {
+			u, err := bucketLifecycleLatestFn(ctx, d.mc, bucketName, *bucket.Spec.ForProvider.LifecycleRules)
+			if err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, "cannot determine whether bucket lifecycle rules are up to date")
+			}
+			isLatest = u
+		}
+
 		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: isLatest}, nil
 	} else if exists {
 		return managed.ExternalObservation{}, fmt.Errorf("bucket already exists, try changing bucket name: %s", bucketName)
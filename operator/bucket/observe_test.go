@@ -17,11 +17,15 @@ import (
 
 func TestProvisioningPipeline_Observe(t *testing.T) {
 	policy := "policy-struct"
+	lifecycleRules := []miniov1beta1.BucketLifecycleRule{
+		{ID: "archive", Transition: &miniov1beta1.BucketLifecycleTransition{Days: 30, TierName: "COLD"}},
+	}
 	tests := map[string]struct {
-		givenBucket  *miniov1beta1.Bucket
-		bucketExists bool
-		returnError  error
-		policyLatest bool
+		givenBucket     *miniov1beta1.Bucket
+		bucketExists    bool
+		returnError     error
+		policyLatest    bool
+		lifecycleLatest bool
 
 		expectedError             string
 		expectedResult            managed.ExternalObservation
@@ -103,6 +107,34 @@ func TestProvisioningPipeline_Observe(t *testing.T) {
 			expectedResult:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
 			expectedBucketObservation: miniov1beta1.BucketProviderStatus{BucketName: "my-bucket"},
 		},
+		"BucketLifecycleRulesChanged": {
+			givenBucket: &miniov1beta1.Bucket{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+					lockAnnotation: "claimed",
+				}},
+				Spec: miniov1beta1.BucketSpec{ForProvider: miniov1beta1.BucketParameters{
+					BucketName:     "my-bucket",
+					LifecycleRules: &lifecycleRules}},
+			},
+			lifecycleLatest:           false,
+			bucketExists:              true,
+			expectedResult:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			expectedBucketObservation: miniov1beta1.BucketProviderStatus{BucketName: "my-bucket"},
+		},
+		"BucketLifecycleRulesNoChangeRequired": {
+			givenBucket: &miniov1beta1.Bucket{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+					lockAnnotation: "claimed",
+				}},
+				Spec: miniov1beta1.BucketSpec{ForProvider: miniov1beta1.BucketParameters{
+					BucketName:     "my-bucket",
+					LifecycleRules: &lifecycleRules}},
+			},
+			lifecycleLatest:           true,
+			bucketExists:              true,
+			expectedResult:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			expectedBucketObservation: miniov1beta1.BucketProviderStatus{BucketName: "my-bucket"},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -115,6 +147,10 @@ func TestProvisioningPipeline_Observe(t *testing.T) {
 				return tc.policyLatest, tc.returnError
 			}
 
+			bucketLifecycleLatestFn = func(ctx context.Context, mc *minio.Client, bucketName string, desiredRules []miniov1beta1.BucketLifecycleRule) (bool, error) {
+				return tc.lifecycleLatest, tc.returnError
+			}
+
 			bucketExistsFn = func(ctx context.Context, mc *minio.Client, bucketName string) (bool, error) {
 				return tc.bucketExists, tc.returnError
 			}
@@ -42,5 +42,13 @@ func (b *bucketClient) Update(ctx context.Context, mg resource.Managed) (managed
 		}
 	}
 
+	if bucket.Spec.ForProvider.LifecycleRules != nil {
+		// An empty configuration removes all lifecycle rules.
+		err := b.mc.SetBucketLifecycle(ctx, bucket.GetBucketName(), desiredLifecycle(*bucket.Spec.ForProvider.LifecycleRules))
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
 	return managed.ExternalUpdate{}, nil
 }
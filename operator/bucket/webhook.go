@@ -24,7 +24,7 @@ func (v *Validator) ValidateCreate(_ context.Context, bucket *miniov1beta1.Bucke
 	if providerConfigRef == nil || providerConfigRef.Name == "" {
 		return nil, fmt.Errorf(".spec.providerConfigRef.name is required")
 	}
-	return nil, nil
+	return nil, validateLifecycleRules(bucket)
 }
 
 // ValidateUpdate implements admission.Validator.
@@ -43,7 +43,7 @@ func (v *Validator) ValidateUpdate(_ context.Context, oldBucket, newBucket *mini
 	if providerConfigRef == nil || providerConfigRef.Name == "" {
 		return nil, field.Invalid(field.NewPath("spec", "providerConfigRef", "name"), "null", "Provider config is required")
 	}
-	return nil, nil
+	return nil, validateLifecycleRules(newBucket)
 }
 
 // ValidateDelete implements admission.Validator.
@@ -51,3 +51,37 @@ func (v *Validator) ValidateDelete(_ context.Context, _ *miniov1beta1.Bucket) (a
 	v.log.V(1).Info("validate delete (noop)")
 	return nil, nil
 }
+
+func validateLifecycleRules(bucket *miniov1beta1.Bucket) error {
+	if bucket.Spec.ForProvider.LifecycleRules == nil {
+		return nil
+	}
+
+	ids := map[string]bool{}
+	for i, rule := range *bucket.Spec.ForProvider.LifecycleRules {
+		path := field.NewPath("spec", "forProvider", "lifecycleRules").Index(i)
+		if rule.ID == "" {
+			return field.Required(path.Child("id"), "Rule ID is required")
+		}
+		if ids[rule.ID] {
+			return field.Duplicate(path.Child("id"), rule.ID)
+		}
+		ids[rule.ID] = true
+
+		if rule.Transition == nil && rule.ExpirationDays == 0 {
+			return field.Required(path, "Either a transition or expirationDays is required")
+		}
+		if rule.ExpirationDays < 0 {
+			return field.Invalid(path.Child("expirationDays"), rule.ExpirationDays, "Expiration days must be positive")
+		}
+		if rule.Transition != nil {
+			if rule.Transition.Days < 1 {
+				return field.Invalid(path.Child("transition", "days"), rule.Transition.Days, "Transition days must be positive")
+			}
+			if rule.Transition.TierName == "" {
+				return field.Required(path.Child("transition", "tierName"), "Tier name is required")
+			}
+		}
+	}
+	return nil
+}
@@ -200,3 +200,62 @@ func TestValidator_ValidateUpdate_PreventZoneChange(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateLifecycleRules(t *testing.T) {
+	transition := &miniov1beta1.BucketLifecycleTransition{Days: 30, TierName: "COLD"}
+	tests := map[string]struct {
+		rules         *[]miniov1beta1.BucketLifecycleRule
+		expectedError string
+	}{
+		"GivenNoRules_ThenExpectNoError": {},
+		"GivenEmptyRules_ThenExpectNoError": {
+			rules: &[]miniov1beta1.BucketLifecycleRule{},
+		},
+		"GivenTransitionAndExpiration_ThenExpectNoError": {
+			rules: &[]miniov1beta1.BucketLifecycleRule{
+				{ID: "archive", Prefix: "logs/", Transition: transition},
+				{ID: "cleanup", Prefix: "tmp/", ExpirationDays: 7},
+			},
+		},
+		"GivenMissingID_ThenExpectError": {
+			rules:         &[]miniov1beta1.BucketLifecycleRule{{Transition: transition}},
+			expectedError: "spec.forProvider.lifecycleRules[0].id: Required value: Rule ID is required",
+		},
+		"GivenDuplicateID_ThenExpectError": {
+			rules: &[]miniov1beta1.BucketLifecycleRule{
+				{ID: "archive", Transition: transition},
+				{ID: "archive", ExpirationDays: 7},
+			},
+			expectedError: `spec.forProvider.lifecycleRules[1].id: Duplicate value: "archive"`,
+		},
+		"GivenNoAction_ThenExpectError": {
+			rules:         &[]miniov1beta1.BucketLifecycleRule{{ID: "archive"}},
+			expectedError: "spec.forProvider.lifecycleRules[0]: Required value: Either a transition or expirationDays is required",
+		},
+		"GivenTransitionWithoutTier_ThenExpectError": {
+			rules: &[]miniov1beta1.BucketLifecycleRule{
+				{ID: "archive", Transition: &miniov1beta1.BucketLifecycleTransition{Days: 30}},
+			},
+			expectedError: "spec.forProvider.lifecycleRules[0].transition.tierName: Required value: Tier name is required",
+		},
+		"GivenTransitionWithoutDays_ThenExpectError": {
+			rules: &[]miniov1beta1.BucketLifecycleRule{
+				{ID: "archive", Transition: &miniov1beta1.BucketLifecycleTransition{TierName: "COLD"}},
+			},
+			expectedError: "spec.forProvider.lifecycleRules[0].transition.days: Invalid value: 0: Transition days must be positive",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			bucket := &miniov1beta1.Bucket{
+				Spec: miniov1beta1.BucketSpec{ForProvider: miniov1beta1.BucketParameters{LifecycleRules: tc.rules}},
+			}
+			err := validateLifecycleRules(bucket)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package ldappolicymapping
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	providerv1 "github.com/rossigee/provider-minio/apis/provider/v1"
+	"github.com/rossigee/provider-minio/operator/minioutil"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	errNotLDAPPolicyMapping = fmt.Errorf("managed resource is not an LDAPPolicyMapping")
+)
+
+type connector struct {
+	kube     client.Client
+	recorder event.Recorder
+	usage    resource.ModernTracker
+}
+
+type ldapPolicyMappingClient struct {
+	ma       *madmin.AdminClient
+	kube     client.Client
+	recorder event.Recorder
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("connecting resource")
+
+	err := c.usage.Track(ctx, mg.(resource.ModernManaged))
+	if err != nil {
+		return nil, err
+	}
+
+	mapping, ok := mg.(*miniov1beta1.LDAPPolicyMapping)
+	if !ok {
+		return nil, errNotLDAPPolicyMapping
+	}
+
+	config, err := c.getProviderConfig(ctx, mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	ma, err := minioutil.NewMinioAdmin(ctx, c.kube, config, miniov1beta1.LDAPPolicyMappingKind)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ldapPolicyMappingClient{
+		ma:       ma,
+		kube:     c.kube,
+		recorder: c.recorder,
+	}, nil
+}
+
+func (c *connector) getProviderConfig(ctx context.Context, mapping *miniov1beta1.LDAPPolicyMapping) (*providerv1.ProviderConfig, error) {
+	configName := mapping.GetProviderConfigReference().Name
+	config := &providerv1.ProviderConfig{}
+	err := c.kube.Get(ctx, client.ObjectKey{Name: configName}, config)
+	return config, err
+}
@@ -0,0 +1,34 @@
+package ldappolicymapping
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (c *ldapPolicyMappingClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("creating resource")
+
+	mapping, ok := mg.(*miniov1beta1.LDAPPolicyMapping)
+	if !ok {
+		return managed.ExternalCreation{}, errNotLDAPPolicyMapping
+	}
+
+	mapping.SetConditions(xpv1.Creating())
+	if err := c.reconcilePolicies(ctx, mapping, nil); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	c.recorder.Event(mapping, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Created",
+		Message: "LDAP policy mapping successfully created",
+	})
+	return managed.ExternalCreation{}, nil
+}
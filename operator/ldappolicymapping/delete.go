@@ -0,0 +1,42 @@
+package ldappolicymapping
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// Delete detaches all policies from the user or group, including the ones attached outside of the resource.
+func (c *ldapPolicyMappingClient) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("deleting resource")
+
+	mapping, ok := mg.(*miniov1beta1.LDAPPolicyMapping)
+	if !ok {
+		return managed.ExternalDelete{}, errNotLDAPPolicyMapping
+	}
+
+	mapping.SetConditions(xpv1.Deleting())
+	current, err := c.observedPolicies(ctx, mapping)
+	if err != nil {
+		return managed.ExternalDelete{}, err
+	}
+	if len(current) > 0 {
+		if _, err := c.ma.DetachPolicyLDAP(ctx, associationReq(mapping, current)); err != nil {
+			return managed.ExternalDelete{}, fmt.Errorf("cannot detach LDAP policies: %w", err)
+		}
+	}
+
+	c.recorder.Event(mapping, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Deleted",
+		Message: "LDAP policy mapping successfully deleted",
+	})
+	return managed.ExternalDelete{}, nil
+}
@@ -0,0 +1,7 @@
+package ldappolicymapping
+
+import "context"
+
+func (c *ldapPolicyMappingClient) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,41 @@
+package ldappolicymapping
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/rossigee/provider-minio/operator/minioutil"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (c *ldapPolicyMappingClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("observing resource")
+
+	mapping, ok := mg.(*miniov1beta1.LDAPPolicyMapping)
+	if !ok {
+		return managed.ExternalObservation{}, errNotLDAPPolicyMapping
+	}
+
+	policies, err := c.observedPolicies(ctx, mapping)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if len(policies) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	mapping.Status.AtProvider.Policies = policies
+
+	attach, detach := minioutil.DiffSets(policies, mapping.Spec.ForProvider.Policies)
+	if len(attach) > 0 || len(detach) > 0 {
+		mapping.SetConditions(miniov1beta1.Updating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	mapping.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
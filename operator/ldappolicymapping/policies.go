@@ -0,0 +1,64 @@
+package ldappolicymapping
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/rossigee/provider-minio/operator/minioutil"
+)
+
+// observedPolicies returns the sorted policies attached to the user or group of the mapping on the server.
+func (c *ldapPolicyMappingClient) observedPolicies(ctx context.Context, mapping *miniov1beta1.LDAPPolicyMapping) ([]string, error) {
+	params := mapping.Spec.ForProvider
+	query := madmin.PolicyEntitiesQuery{}
+	if params.User != "" {
+		query.Users = []string{params.User}
+	} else {
+		query.Groups = []string{params.Group}
+	}
+
+	result, err := c.ma.GetLDAPPolicyEntities(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get LDAP policy mappings: %w", err)
+	}
+
+	policies := []string{}
+	for _, userMapping := range result.UserMappings {
+		policies = append(policies, userMapping.Policies...)
+	}
+	for _, groupMapping := range result.GroupMappings {
+		policies = append(policies, groupMapping.Policies...)
+	}
+	sort.Strings(policies)
+	return policies, nil
+}
+
+// associationReq returns the request to attach or detach the given policies to the user or group of the mapping.
+func associationReq(mapping *miniov1beta1.LDAPPolicyMapping, policies []string) madmin.PolicyAssociationReq {
+	return madmin.PolicyAssociationReq{
+		Policies: policies,
+		User:     mapping.Spec.ForProvider.User,
+		Group:    mapping.Spec.ForProvider.Group,
+	}
+}
+
+// reconcilePolicies attaches and detaches only the policies that differ between current and desired.
+// New policies are attached before obsolete ones are detached, so the user or group never loses access it keeps.
+func (c *ldapPolicyMappingClient) reconcilePolicies(ctx context.Context, mapping *miniov1beta1.LDAPPolicyMapping, current []string) error {
+	attach, detach := minioutil.DiffSets(current, mapping.Spec.ForProvider.Policies)
+
+	if len(attach) > 0 {
+		if _, err := c.ma.AttachPolicyLDAP(ctx, associationReq(mapping, attach)); err != nil {
+			return fmt.Errorf("cannot attach LDAP policies: %w", err)
+		}
+	}
+	if len(detach) > 0 {
+		if _, err := c.ma.DetachPolicyLDAP(ctx, associationReq(mapping, detach)); err != nil {
+			return fmt.Errorf("cannot detach LDAP policies: %w", err)
+		}
+	}
+	return nil
+}
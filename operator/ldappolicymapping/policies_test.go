@@ -0,0 +1,147 @@
+package ldappolicymapping
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const groupDN = "cn=storage-admins,ou=groups,dc=example,dc=com"
+
+func newLDAPPolicyMapping(params miniov1beta1.LDAPPolicyMappingParameters) *miniov1beta1.LDAPPolicyMapping {
+	return &miniov1beta1.LDAPPolicyMapping{
+		ObjectMeta: metav1.ObjectMeta{Name: "storage-admins", Namespace: "default"},
+		Spec: miniov1beta1.LDAPPolicyMappingSpec{
+			ManagedResourceSpec: xpv1.ManagedResourceSpec{ProviderConfigReference: &xpv1.ProviderConfigReference{Name: "minio"}},
+			ForProvider:         params,
+		},
+	}
+}
+
+type eventRecorder struct {
+	events []event.Event
+}
+
+func (r *eventRecorder) Event(_ runtime.Object, e event.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *eventRecorder) WithAnnotations(_ ...string) event.Recorder {
+	return r
+}
+
+// fakeServer serves the LDAP policy mappings of a single group and records attach and detach requests.
+type fakeServer struct {
+	policies []string
+	attached []madmin.PolicyAssociationReq
+	detached []madmin.PolicyAssociationReq
+}
+
+func (s *fakeServer) newAdminClient(t *testing.T) *madmin.AdminClient {
+	writeEncrypted := func(w http.ResponseWriter, v any) {
+		data, err := json.Marshal(v)
+		require.NoError(t, err)
+		encrypted, err := madmin.EncryptData("password", data)
+		require.NoError(t, err)
+		_, _ = w.Write(encrypted)
+	}
+	readEncrypted := func(r *http.Request) madmin.PolicyAssociationReq {
+		data, err := madmin.DecryptData("password", r.Body)
+		require.NoError(t, err)
+		req := madmin.PolicyAssociationReq{}
+		require.NoError(t, json.Unmarshal(data, &req))
+		return req
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/minio/admin/v3/idp/ldap/policy-entities":
+			result := madmin.PolicyEntitiesResult{}
+			if len(s.policies) > 0 {
+				result.GroupMappings = []madmin.GroupPolicyEntities{{Group: r.URL.Query().Get("group"), Policies: s.policies}}
+			}
+			writeEncrypted(w, result)
+		case "/minio/admin/v3/idp/ldap/policy/attach":
+			s.attached = append(s.attached, readEncrypted(r))
+			writeEncrypted(w, madmin.PolicyAssociationResp{})
+		case "/minio/admin/v3/idp/ldap/policy/detach":
+			s.detached = append(s.detached, readEncrypted(r))
+			writeEncrypted(w, madmin.PolicyAssociationResp{})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	parsed, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	ma, err := madmin.New(parsed.Host, "admin", "password", false)
+	require.NoError(t, err)
+	return ma
+}
+
+func TestObserve(t *testing.T) {
+	tests := map[string]struct {
+		policies         []string
+		expectedExists   bool
+		expectedUpToDate bool
+	}{
+		"NotFound": {},
+		"UpToDate": {
+			policies:         []string{"readwrite", "diagnostics"},
+			expectedExists:   true,
+			expectedUpToDate: true,
+		},
+		"ExtraPolicy": {
+			policies:       []string{"readwrite", "diagnostics", "consoleAdmin"},
+			expectedExists: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := &fakeServer{policies: tc.policies}
+			mappingClient := &ldapPolicyMappingClient{ma: server.newAdminClient(t), recorder: &eventRecorder{}}
+			mapping := newLDAPPolicyMapping(miniov1beta1.LDAPPolicyMappingParameters{Group: groupDN, Policies: []string{"diagnostics", "readwrite"}})
+
+			observation, err := mappingClient.Observe(context.Background(), mapping)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedExists, observation.ResourceExists)
+			assert.Equal(t, tc.expectedUpToDate, observation.ResourceUpToDate)
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	server := &fakeServer{policies: []string{"consoleAdmin", "readwrite"}}
+	mappingClient := &ldapPolicyMappingClient{ma: server.newAdminClient(t), recorder: &eventRecorder{}}
+	mapping := newLDAPPolicyMapping(miniov1beta1.LDAPPolicyMappingParameters{Group: groupDN, Policies: []string{"readwrite", "diagnostics"}})
+
+	_, err := mappingClient.Update(context.Background(), mapping)
+	require.NoError(t, err)
+	assert.Equal(t, []madmin.PolicyAssociationReq{{Policies: []string{"diagnostics"}, Group: groupDN}}, server.attached)
+	assert.Equal(t, []madmin.PolicyAssociationReq{{Policies: []string{"consoleAdmin"}, Group: groupDN}}, server.detached)
+}
+
+func TestDelete(t *testing.T) {
+	server := &fakeServer{policies: []string{"consoleAdmin", "readwrite"}}
+	mappingClient := &ldapPolicyMappingClient{ma: server.newAdminClient(t), recorder: &eventRecorder{}}
+	mapping := newLDAPPolicyMapping(miniov1beta1.LDAPPolicyMappingParameters{Group: groupDN, Policies: []string{"readwrite"}})
+
+	_, err := mappingClient.Delete(context.Background(), mapping)
+	require.NoError(t, err)
+	assert.Empty(t, server.attached)
+	assert.Equal(t, []madmin.PolicyAssociationReq{{Policies: []string{"consoleAdmin", "readwrite"}, Group: groupDN}}, server.detached)
+}
@@ -0,0 +1,36 @@
+package ldappolicymapping
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (c *ldapPolicyMappingClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("updating resource")
+
+	mapping, ok := mg.(*miniov1beta1.LDAPPolicyMapping)
+	if !ok {
+		return managed.ExternalUpdate{}, errNotLDAPPolicyMapping
+	}
+
+	current, err := c.observedPolicies(ctx, mapping)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := c.reconcilePolicies(ctx, mapping, current); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	c.recorder.Event(mapping, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Updated",
+		Message: "LDAP policy mapping successfully updated",
+	})
+	return managed.ExternalUpdate{}, nil
+}
@@ -0,0 +1,84 @@
+package ldappolicymapping
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-logr/logr"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ admission.Validator[*miniov1beta1.LDAPPolicyMapping] = &Validator{}
+
+// Validator validates admission requests.
+type Validator struct {
+	log logr.Logger
+}
+
+// ValidateCreate implements admission.Validator.
+func (v *Validator) ValidateCreate(_ context.Context, mapping *miniov1beta1.LDAPPolicyMapping) (admission.Warnings, error) {
+	v.log.V(1).Info("Validate create")
+	return nil, validateMapping(mapping)
+}
+
+// ValidateUpdate implements admission.Validator.
+func (v *Validator) ValidateUpdate(_ context.Context, oldMapping, newMapping *miniov1beta1.LDAPPolicyMapping) (admission.Warnings, error) {
+	v.log.V(1).Info("Validate update")
+
+	if newMapping.GetDeletionTimestamp() != nil {
+		return nil, nil
+	}
+
+	path := field.NewPath("spec", "forProvider")
+	if newMapping.Spec.ForProvider.User != oldMapping.Spec.ForProvider.User {
+		return nil, field.Invalid(path.Child("user"), newMapping.Spec.ForProvider.User, "Changing the user is not allowed")
+	}
+	if newMapping.Spec.ForProvider.Group != oldMapping.Spec.ForProvider.Group {
+		return nil, field.Invalid(path.Child("group"), newMapping.Spec.ForProvider.Group, "Changing the group is not allowed")
+	}
+
+	return nil, validateMapping(newMapping)
+}
+
+// ValidateDelete implements admission.Validator.
+func (v *Validator) ValidateDelete(_ context.Context, _ *miniov1beta1.LDAPPolicyMapping) (admission.Warnings, error) {
+	v.log.V(1).Info("validate delete (noop)")
+	return nil, nil
+}
+
+func validateMapping(mapping *miniov1beta1.LDAPPolicyMapping) error {
+	providerConfigRef := mapping.Spec.ProviderConfigReference
+	if providerConfigRef == nil || providerConfigRef.Name == "" {
+		return field.Invalid(field.NewPath("spec", "providerConfigRef", "name"), "null", "Provider config is required")
+	}
+
+	params := mapping.Spec.ForProvider
+	path := field.NewPath("spec", "forProvider")
+	switch {
+	case params.User == "" && params.Group == "":
+		return field.Required(path.Child("user"), "Either a user or a group DN is required")
+	case params.User != "" && params.Group != "":
+		return field.Forbidden(path.Child("group"), "Either a user or a group DN must be given, not both")
+	case params.User != "" && !strings.Contains(params.User, "="):
+		return field.Invalid(path.Child("user"), params.User, "User must be a DN, e.g. cn=alice,ou=users,dc=example,dc=com")
+	case params.Group != "" && !strings.Contains(params.Group, "="):
+		return field.Invalid(path.Child("group"), params.Group, "Group must be a DN, e.g. cn=admins,ou=groups,dc=example,dc=com")
+	}
+
+	if len(params.Policies) == 0 {
+		return field.Required(path.Child("policies"), "At least one policy is required")
+	}
+	seen := map[string]bool{}
+	for i, policy := range params.Policies {
+		if policy == "" || strings.Contains(policy, ",") {
+			return field.Invalid(path.Child("policies").Index(i), policy, "Policy name must not be empty or contain commas")
+		}
+		if seen[policy] {
+			return field.Duplicate(path.Child("policies").Index(i), policy)
+		}
+		seen[policy] = true
+	}
+	return nil
+}
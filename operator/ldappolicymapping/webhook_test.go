@@ -0,0 +1,70 @@
+package ldappolicymapping
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateMapping(t *testing.T) {
+	tests := map[string]struct {
+		params  miniov1beta1.LDAPPolicyMappingParameters
+		wantErr string
+	}{
+		"Group": {
+			params: miniov1beta1.LDAPPolicyMappingParameters{Group: groupDN, Policies: []string{"readwrite"}},
+		},
+		"User": {
+			params: miniov1beta1.LDAPPolicyMappingParameters{User: "cn=alice,ou=users,dc=example,dc=com", Policies: []string{"readonly"}},
+		},
+		"NoUserOrGroup": {
+			params:  miniov1beta1.LDAPPolicyMappingParameters{Policies: []string{"readonly"}},
+			wantErr: "Either a user or a group DN is required",
+		},
+		"UserAndGroup": {
+			params:  miniov1beta1.LDAPPolicyMappingParameters{User: "cn=alice,ou=users,dc=example,dc=com", Group: groupDN, Policies: []string{"readonly"}},
+			wantErr: "not both",
+		},
+		"NotADN": {
+			params:  miniov1beta1.LDAPPolicyMappingParameters{User: "alice", Policies: []string{"readonly"}},
+			wantErr: "User must be a DN",
+		},
+		"NoPolicies": {
+			params:  miniov1beta1.LDAPPolicyMappingParameters{Group: groupDN},
+			wantErr: "At least one policy is required",
+		},
+		"DuplicatePolicy": {
+			params:  miniov1beta1.LDAPPolicyMappingParameters{Group: groupDN, Policies: []string{"readonly", "readonly"}},
+			wantErr: "Duplicate value",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateMapping(newLDAPPolicyMapping(tc.params))
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestValidateUpdate_Immutable(t *testing.T) {
+	v := &Validator{log: logr.Discard()}
+	oldMapping := newLDAPPolicyMapping(miniov1beta1.LDAPPolicyMappingParameters{Group: groupDN, Policies: []string{"readwrite"}})
+
+	newMapping := oldMapping.DeepCopy()
+	newMapping.Spec.ForProvider.Policies = []string{"readonly"}
+	_, err := v.ValidateUpdate(context.Background(), oldMapping, newMapping)
+	assert.NoError(t, err)
+
+	newMapping = oldMapping.DeepCopy()
+	newMapping.Spec.ForProvider.Group = "cn=other,ou=groups,dc=example,dc=com"
+	_, err = v.ValidateUpdate(context.Background(), oldMapping, newMapping)
+	assert.ErrorContains(t, err, "Changing the group is not allowed")
+}
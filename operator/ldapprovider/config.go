@@ -0,0 +1,99 @@
+package ldapprovider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/rossigee/provider-minio/operator/minioutil"
+)
+
+const (
+	serverAddressKey      = "server_addr"
+	lookupBindDNKey       = "lookup_bind_dn"
+	lookupBindPasswordKey = "lookup_bind_password"
+	userDNSearchBaseKey   = "user_dn_search_base_dn"
+	userDNSearchFilterKey = "user_dn_search_filter"
+	groupSearchBaseKey    = "group_search_base_dn"
+	groupSearchFilterKey  = "group_search_filter"
+	startTLSKey           = "server_starttls"
+	serverInsecureKey     = "server_insecure"
+	tlsSkipVerifyKey      = "tls_skip_verify"
+)
+
+// reservedKeys are the configuration parameters that are set by dedicated fields.
+var reservedKeys = []string{
+	serverAddressKey, lookupBindDNKey, lookupBindPasswordKey, userDNSearchBaseKey, userDNSearchFilterKey,
+	groupSearchBaseKey, groupSearchFilterKey, startTLSKey, serverInsecureKey, tlsSkipVerifyKey,
+}
+
+// plainConfig returns the configuration parameters of the provider that are not read from Secrets.
+// Optional parameters are always set, so that removing them from the spec resets them on the server.
+func plainConfig(params miniov1beta1.LDAPProviderParameters) map[string]string {
+	config := map[string]string{}
+	for key, value := range params.Config {
+		config[key] = value
+	}
+	config[serverAddressKey] = params.ServerAddress
+	config[lookupBindDNKey] = params.LookupBindDN
+	config[userDNSearchBaseKey] = params.UserDNSearchBaseDN
+	config[userDNSearchFilterKey] = params.UserDNSearchFilter
+	config[groupSearchBaseKey] = params.GroupSearchBaseDN
+	config[groupSearchFilterKey] = params.GroupSearchFilter
+	config[startTLSKey] = onOff(params.StartTLS)
+	config[serverInsecureKey] = onOff(params.ServerInsecure)
+	config[tlsSkipVerifyKey] = onOff(params.TLS != nil && params.TLS.InsecureSkipVerify)
+	return config
+}
+
+func onOff(value bool) string {
+	if value {
+		return "on"
+	}
+	return "off"
+}
+
+// configData returns the configuration parameters in the format of `mc admin idp ldap add`.
+func configData(config map[string]string) string {
+	return strings.Join(minioutil.ConfigPairs(config), madmin.KvSpaceSeparator)
+}
+
+// isConfigUpToDate returns true if the observed configuration has all the parameters that are not read from Secrets.
+// Parameters set by environment variables on the server are skipped, the server ignores the configured values.
+func isConfigUpToDate(provider *miniov1beta1.LDAPProvider, idpConfig madmin.IDPConfig) bool {
+	observed, envKeys := minioutil.IDPConfigValues(idpConfig)
+	for key, value := range plainConfig(provider.Spec.ForProvider) {
+		if slices.Contains(envKeys, key) {
+			continue
+		}
+		observedValue := observed[key]
+		if value == "off" && observedValue == "" {
+			continue
+		}
+		if observedValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+// getBindPassword returns the lookup bind password together with the resource version of the Secret holding it.
+// It returns empty strings if the provider has no password.
+func (c *ldapProviderClient) getBindPassword(ctx context.Context, provider *miniov1beta1.LDAPProvider) (string, string, error) {
+	ref := provider.Spec.ForProvider.LookupBindPasswordSecretRef
+	if ref == nil {
+		return "", "", nil
+	}
+
+	value, version, err := minioutil.GetSecretValue(ctx, c.kube, provider.GetNamespace(), *ref)
+	if err != nil {
+		return "", "", err
+	}
+	if value == "" {
+		return "", "", fmt.Errorf("secret %q has no value for key %q", ref.Name, ref.Key)
+	}
+	return value, version, nil
+}
@@ -0,0 +1,85 @@
+package ldapprovider
+
+import (
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	"github.com/minio/madmin-go/v3"
+	"github.com/rossigee/provider-minio/apis/common"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newLDAPProvider(params miniov1beta1.LDAPProviderParameters) *miniov1beta1.LDAPProvider {
+	return &miniov1beta1.LDAPProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "ad", Namespace: "default"},
+		Spec: miniov1beta1.LDAPProviderSpec{
+			ManagedResourceSpec: xpv1.ManagedResourceSpec{ProviderConfigReference: &xpv1.ProviderConfigReference{Name: "minio"}},
+			ForProvider:         params,
+		},
+	}
+}
+
+func adParams() miniov1beta1.LDAPProviderParameters {
+	return miniov1beta1.LDAPProviderParameters{
+		ServerAddress:      "ad.example.com:636",
+		LookupBindDN:       "cn=minio,ou=services,dc=example,dc=com",
+		UserDNSearchBaseDN: "ou=users,dc=example,dc=com",
+		UserDNSearchFilter: "(&(objectCategory=user)(sAMAccountName=%s))",
+		GroupSearchBaseDN:  "ou=groups,dc=example,dc=com",
+		GroupSearchFilter:  "(&(objectclass=group)(member=%d))",
+	}
+}
+
+func TestConfigData(t *testing.T) {
+	params := adParams()
+	params.TLS = &common.TLSConfig{InsecureSkipVerify: true}
+
+	assert.Equal(t,
+		`group_search_base_dn="ou=groups,dc=example,dc=com" group_search_filter="(&(objectclass=group)(member=%d))" `+
+			`lookup_bind_dn="cn=minio,ou=services,dc=example,dc=com" server_addr="ad.example.com:636" server_insecure="off" `+
+			`server_starttls="off" tls_skip_verify="on" user_dn_search_base_dn="ou=users,dc=example,dc=com" `+
+			`user_dn_search_filter="(&(objectCategory=user)(sAMAccountName=%s))"`,
+		configData(plainConfig(params)))
+}
+
+func TestIsConfigUpToDate(t *testing.T) {
+	params := adParams()
+	info := []madmin.IDPCfgInfo{
+		{Key: serverAddressKey, Value: params.ServerAddress, IsCfg: true},
+		{Key: lookupBindDNKey, Value: params.LookupBindDN, IsCfg: true},
+		{Key: userDNSearchBaseKey, Value: params.UserDNSearchBaseDN, IsCfg: true},
+		{Key: userDNSearchFilterKey, Value: params.UserDNSearchFilter, IsCfg: true},
+		{Key: groupSearchBaseKey, Value: params.GroupSearchBaseDN, IsCfg: true},
+		{Key: groupSearchFilterKey, Value: "(member=%d)", IsCfg: true, IsEnv: true},
+	}
+
+	tests := map[string]struct {
+		modify   func(*miniov1beta1.LDAPProviderParameters)
+		expected bool
+	}{
+		"UpToDate": {
+			modify:   func(*miniov1beta1.LDAPProviderParameters) {},
+			expected: true,
+		},
+		"ServerChanged": {
+			modify: func(p *miniov1beta1.LDAPProviderParameters) { p.ServerAddress = "ad2.example.com:636" },
+		},
+		"StartTLSEnabled": {
+			modify: func(p *miniov1beta1.LDAPProviderParameters) { p.StartTLS = true },
+		},
+		"EnvOverridden": {
+			modify:   func(p *miniov1beta1.LDAPProviderParameters) { p.GroupSearchFilter = "(member=%s)" },
+			expected: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := adParams()
+			tc.modify(&params)
+			assert.Equal(t, tc.expected, isConfigUpToDate(newLDAPProvider(params), madmin.IDPConfig{Type: "ldap", Info: info}))
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package ldapprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (c *ldapProviderClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("creating resource")
+
+	provider, ok := mg.(*miniov1beta1.LDAPProvider)
+	if !ok {
+		return managed.ExternalCreation{}, errNotLDAPProvider
+	}
+
+	provider.SetConditions(xpv1.Creating())
+	if err := c.applyConfig(ctx, provider, false); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	c.recorder.Event(provider, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Created",
+		Message: "LDAP provider successfully created",
+	})
+	return managed.ExternalCreation{}, nil
+}
+
+// applyConfig adds or updates the configuration of the provider on the server and records
+// whether the server has to be restarted to apply it.
+func (c *ldapProviderClient) applyConfig(ctx context.Context, provider *miniov1beta1.LDAPProvider, update bool) error {
+	config := plainConfig(provider.Spec.ForProvider)
+	bindPassword, version, err := c.getBindPassword(ctx, provider)
+	if err != nil {
+		return err
+	}
+	if bindPassword != "" {
+		config[lookupBindPasswordKey] = bindPassword
+	}
+
+	restart, err := c.ma.AddOrUpdateIDPConfig(ctx, madmin.LDAPIDPCfg, madmin.Default, configData(config), update)
+	if err != nil {
+		return fmt.Errorf("cannot set LDAP provider configuration: %w", err)
+	}
+
+	provider.Status.AtProvider.ObservedSecretVersion = version
+	c.setRestartRequired(provider, restart)
+	return nil
+}
+
+// setRestartRequired records whether the server has to be restarted to load the LDAP configuration
+// and emits a Warning event if so.
+func (c *ldapProviderClient) setRestartRequired(provider *miniov1beta1.LDAPProvider, restart bool) {
+	provider.Status.AtProvider.RestartRequired = restart
+	if restart {
+		c.recorder.Event(provider, event.Event{
+			Type:    event.TypeWarning,
+			Reason:  "RestartRequired",
+			Message: "The MinIO server must be restarted to apply the LDAP provider configuration",
+		})
+	}
+}
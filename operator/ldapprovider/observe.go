@@ -0,0 +1,59 @@
+package ldapprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/rossigee/provider-minio/operator/minioutil"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (c *ldapProviderClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("observing resource")
+
+	provider, ok := mg.(*miniov1beta1.LDAPProvider)
+	if !ok {
+		return managed.ExternalObservation{}, errNotLDAPProvider
+	}
+
+	// The server has a single LDAP configuration, which exists if it has a server address.
+	idpConfig, err := c.ma.GetIDPConfig(ctx, madmin.LDAPIDPCfg, madmin.Default)
+	if err != nil {
+		return managed.ExternalObservation{}, fmt.Errorf("cannot get LDAP provider configuration: %w", err)
+	}
+	if observed, _ := minioutil.IDPConfigValues(idpConfig); observed[serverAddressKey] == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// An LDAP configuration that this resource did not create was set up by an administrator or another
+	// LDAPProvider and is not adopted. It is left in place when the resource is deleted.
+	if meta.GetExternalCreateSucceeded(provider).IsZero() {
+		if provider.GetDeletionTimestamp() != nil {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errNotCreated
+	}
+
+	c.observeState(ctx, provider)
+
+	// The bind password is not compared, changes are detected by the resource version of the Secret.
+	_, version, err := c.getBindPassword(ctx, provider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if !isConfigUpToDate(provider, idpConfig) || version != provider.Status.AtProvider.ObservedSecretVersion {
+		provider.SetConditions(miniov1beta1.Updating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	provider.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
@@ -0,0 +1,97 @@
+package ldapprovider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/minio/madmin-go/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// newAdminClient returns an admin client for a fake server with the given LDAP configuration.
+func newAdminClient(t *testing.T, idpConfig madmin.IDPConfig) *madmin.AdminClient {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/minio/admin/v3/idp-config/ldap/_":
+			data, err := json.Marshal(idpConfig)
+			require.NoError(t, err)
+			encrypted, err := madmin.EncryptData("password", data)
+			require.NoError(t, err)
+			_, _ = w.Write(encrypted)
+		case "/minio/admin/v3/info":
+			_ = json.NewEncoder(w).Encode(madmin.InfoMessage{Services: madmin.Services{LDAP: madmin.LDAP{Status: string(madmin.ItemOnline)}}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	parsed, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	ma, err := madmin.New(parsed.Host, "admin", "password", false)
+	require.NoError(t, err)
+	return ma
+}
+
+func TestObserve_Adoption(t *testing.T) {
+	params := adParams()
+	configured := madmin.IDPConfig{Type: "ldap", Name: madmin.Default}
+	for key, value := range plainConfig(params) {
+		configured.Info = append(configured.Info, madmin.IDPCfgInfo{Key: key, Value: value, IsCfg: true})
+	}
+
+	tests := map[string]struct {
+		idpConfig      madmin.IDPConfig
+		created        bool
+		deleting       bool
+		expectedExists bool
+		expectedError  error
+	}{
+		"GivenNoConfiguration_ThenNotExists": {
+			idpConfig: madmin.IDPConfig{Type: "ldap", Name: madmin.Default},
+		},
+		"GivenCreatedByResource_ThenExists": {
+			idpConfig:      configured,
+			created:        true,
+			expectedExists: true,
+		},
+		"GivenNotCreatedByResource_ThenError": {
+			idpConfig:     configured,
+			expectedError: errNotCreated,
+		},
+		"GivenNotCreatedByResourceAndDeleting_ThenLeftInPlace": {
+			idpConfig: configured,
+			deleting:  true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			providerClient := &ldapProviderClient{ma: newAdminClient(t, tc.idpConfig), recorder: event.NewNopRecorder()}
+			provider := newLDAPProvider(params)
+			if tc.created {
+				meta.SetExternalCreateSucceeded(provider, time.Now())
+			}
+			if tc.deleting {
+				now := metav1.Now()
+				provider.SetDeletionTimestamp(&now)
+			}
+
+			observation, err := providerClient.Observe(context.Background(), provider)
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedExists, observation.ResourceExists)
+		})
+	}
+}
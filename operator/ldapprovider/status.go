@@ -0,0 +1,36 @@
+package ldapprovider
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// observeState sets the state of the LDAP server and the Reachable condition from the server info.
+// The state is best effort, it is left unchanged if the server info cannot be retrieved.
+func (c *ldapProviderClient) observeState(ctx context.Context, provider *miniov1beta1.LDAPProvider) {
+	info, err := c.ma.ServerInfo(ctx)
+	if err != nil {
+		ctrl.LoggerFrom(ctx).V(1).Info("cannot get server info, skipping LDAP state", "error", err.Error())
+		return
+	}
+
+	state := info.Services.LDAP.Status
+	provider.Status.AtProvider.State = state
+	switch state {
+	case string(madmin.ItemOnline):
+		provider.SetConditions(miniov1beta1.Reachable())
+	case string(madmin.ItemOffline):
+		if provider.GetCondition(miniov1beta1.TypeReachable).Reason != miniov1beta1.Unreachable("").Reason {
+			c.recorder.Event(provider, event.Event{
+				Type:    event.TypeWarning,
+				Reason:  "Unreachable",
+				Message: "The MinIO server cannot reach the LDAP server",
+			})
+		}
+		provider.SetConditions(miniov1beta1.Unreachable("The MinIO server cannot reach the LDAP server"))
+	}
+}
@@ -0,0 +1,153 @@
+package ldapprovider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ admission.Validator[*miniov1beta1.LDAPProvider] = &Validator{}
+
+var configKeyPattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// Validator validates admission requests.
+type Validator struct {
+	log  logr.Logger
+	kube client.Client
+}
+
+// ValidateCreate implements admission.Validator.
+func (v *Validator) ValidateCreate(ctx context.Context, provider *miniov1beta1.LDAPProvider) (admission.Warnings, error) {
+	v.log.V(1).Info("Validate create")
+	if err := validateProvider(provider); err != nil {
+		return nil, err
+	}
+	return nil, v.validateSingleton(ctx, provider)
+}
+
+// ValidateUpdate implements admission.Validator.
+func (v *Validator) ValidateUpdate(ctx context.Context, _, newProvider *miniov1beta1.LDAPProvider) (admission.Warnings, error) {
+	v.log.V(1).Info("Validate update")
+
+	if newProvider.GetDeletionTimestamp() != nil {
+		return nil, nil
+	}
+
+	if err := validateProvider(newProvider); err != nil {
+		return nil, err
+	}
+	return nil, v.validateSingleton(ctx, newProvider)
+}
+
+// ValidateDelete implements admission.Validator.
+func (v *Validator) ValidateDelete(_ context.Context, _ *miniov1beta1.LDAPProvider) (admission.Warnings, error) {
+	v.log.V(1).Info("validate delete (noop)")
+	return nil, nil
+}
+
+func validateProvider(provider *miniov1beta1.LDAPProvider) error {
+	providerConfigRef := provider.Spec.ProviderConfigReference
+	if providerConfigRef == nil || providerConfigRef.Name == "" {
+		return field.Invalid(field.NewPath("spec", "providerConfigRef", "name"), "null", "Provider config is required")
+	}
+
+	params := provider.Spec.ForProvider
+	path := field.NewPath("spec", "forProvider")
+	fields := []struct {
+		name     string
+		value    string
+		required bool
+	}{
+		{name: "serverAddress", value: params.ServerAddress, required: true},
+		{name: "lookupBindDn", value: params.LookupBindDN, required: true},
+		{name: "userDnSearchBaseDn", value: params.UserDNSearchBaseDN, required: true},
+		{name: "userDnSearchFilter", value: params.UserDNSearchFilter, required: true},
+		{name: "groupSearchBaseDn", value: params.GroupSearchBaseDN},
+		{name: "groupSearchFilter", value: params.GroupSearchFilter},
+	}
+	for _, f := range fields {
+		if f.required && f.value == "" {
+			return field.Required(path.Child(f.name), "Field is required")
+		}
+		if strings.Contains(f.value, madmin.KvDoubleQuote) {
+			return field.Invalid(path.Child(f.name), f.value, "Value must not contain double quotes")
+		}
+	}
+	if strings.Contains(params.ServerAddress, "://") {
+		return field.Invalid(path.Child("serverAddress"), params.ServerAddress, "Server address must be host:port without a scheme")
+	}
+	if !strings.Contains(params.UserDNSearchFilter, "%s") {
+		return field.Invalid(path.Child("userDnSearchFilter"), params.UserDNSearchFilter, "User DN search filter must contain '%s' for the username")
+	}
+	if (params.GroupSearchBaseDN == "") != (params.GroupSearchFilter == "") {
+		return field.Required(path.Child("groupSearchFilter"), "Group search base DN and filter must be set together")
+	}
+	if params.StartTLS && params.ServerInsecure {
+		return field.Forbidden(path.Child("startTls"), "StartTLS cannot be combined with an insecure connection")
+	}
+	if err := validateTLS(path.Child("tls"), params); err != nil {
+		return err
+	}
+
+	for key, value := range params.Config {
+		keyPath := path.Child("config").Key(key)
+		if !configKeyPattern.MatchString(key) {
+			return field.Invalid(keyPath, key, "Key must only contain lowercase letters, digits and '_'")
+		}
+		if slices.Contains(reservedKeys, key) {
+			return field.Forbidden(keyPath, "Key is set by a dedicated field")
+		}
+		if strings.Contains(value, madmin.KvDoubleQuote) {
+			return field.Invalid(keyPath, value, "Value must not contain double quotes")
+		}
+	}
+	return nil
+}
+
+// validateSingleton rejects a second LDAPProvider for the same ProviderConfig in any namespace.
+// The server has a single LDAP configuration, two resources would overwrite each other's settings.
+func (v *Validator) validateSingleton(ctx context.Context, provider *miniov1beta1.LDAPProvider) error {
+	providers := &miniov1beta1.LDAPProviderList{}
+	if err := v.kube.List(ctx, providers); err != nil {
+		return fmt.Errorf("cannot list LDAP providers: %w", err)
+	}
+	configName := provider.GetProviderConfigReference().Name
+	for _, other := range providers.Items {
+		if other.GetNamespace() == provider.GetNamespace() && other.GetName() == provider.GetName() {
+			continue
+		}
+		if ref := other.GetProviderConfigReference(); ref != nil && ref.Name == configName {
+			return field.Forbidden(field.NewPath("spec", "providerConfigRef", "name"),
+				fmt.Sprintf("LDAPProvider %s/%s already configures LDAP on this provider config, a server has a single LDAP configuration", other.GetNamespace(), other.GetName()))
+		}
+	}
+	return nil
+}
+
+// validateTLS rejects the TLS settings the MinIO server cannot apply to LDAP connections.
+// The server verifies the LDAP server with its own CA certificates and does not support client certificates.
+func validateTLS(path *field.Path, params miniov1beta1.LDAPProviderParameters) error {
+	tls := params.TLS
+	if tls == nil {
+		return nil
+	}
+	if tls.CAData != "" || tls.CASecretRef != nil || tls.CAConfigMapRef != nil {
+		return field.Forbidden(path, "CA certificates are not supported, install them in the certs/CAs directory of the MinIO server")
+	}
+	if tls.ClientCertData != "" || tls.ClientCertSecretRef != nil || tls.ClientKeyData != "" || tls.ClientKeySecretRef != nil {
+		return field.Forbidden(path, "Client certificates are not supported for LDAP connections")
+	}
+	if tls.InsecureSkipVerify && params.ServerInsecure {
+		return field.Forbidden(path.Child("insecureSkipVerify"), "Certificate verification cannot be skipped for an insecure connection")
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package ldapprovider
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	"github.com/go-logr/logr/testr"
+	"github.com/rossigee/provider-minio/apis/common"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestValidateProvider(t *testing.T) {
+	tests := map[string]struct {
+		modify  func(*miniov1beta1.LDAPProviderParameters)
+		wantErr string
+	}{
+		"Valid": {
+			modify: func(*miniov1beta1.LDAPProviderParameters) {},
+		},
+		"SkipVerify": {
+			modify: func(p *miniov1beta1.LDAPProviderParameters) { p.TLS = &common.TLSConfig{InsecureSkipVerify: true} },
+		},
+		"MissingServerAddress": {
+			modify:  func(p *miniov1beta1.LDAPProviderParameters) { p.ServerAddress = "" },
+			wantErr: "spec.forProvider.serverAddress: Required value",
+		},
+		"ServerAddressWithScheme": {
+			modify:  func(p *miniov1beta1.LDAPProviderParameters) { p.ServerAddress = "ldaps://ad.example.com:636" },
+			wantErr: "without a scheme",
+		},
+		"FilterWithoutUsername": {
+			modify:  func(p *miniov1beta1.LDAPProviderParameters) { p.UserDNSearchFilter = "(objectCategory=user)" },
+			wantErr: "must contain '%s'",
+		},
+		"GroupFilterWithoutBase": {
+			modify:  func(p *miniov1beta1.LDAPProviderParameters) { p.GroupSearchBaseDN = "" },
+			wantErr: "must be set together",
+		},
+		"CAData": {
+			modify: func(p *miniov1beta1.LDAPProviderParameters) {
+				p.TLS = &common.TLSConfig{CAData: "-----BEGIN CERTIFICATE-----"}
+			},
+			wantErr: "CA certificates are not supported",
+		},
+		"ClientCert": {
+			modify: func(p *miniov1beta1.LDAPProviderParameters) {
+				p.TLS = &common.TLSConfig{ClientCertSecretRef: &corev1.SecretKeySelector{Key: "tls.crt"}}
+			},
+			wantErr: "Client certificates are not supported",
+		},
+		"ReservedKey": {
+			modify: func(p *miniov1beta1.LDAPProviderParameters) {
+				p.Config = map[string]string{"lookup_bind_password": "secret"}
+			},
+			wantErr: "set by a dedicated field",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := adParams()
+			tc.modify(&params)
+			err := validateProvider(newLDAPProvider(params))
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestValidateCreate_Singleton(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, miniov1beta1.SchemeBuilder.AddToScheme(scheme))
+	existing := newLDAPProvider(adParams())
+	existing.ObjectMeta = metav1.ObjectMeta{Name: "ad", Namespace: "team-a"}
+
+	tests := map[string]struct {
+		namespace      string
+		providerConfig string
+		wantErr        string
+	}{
+		"GivenSameProviderConfigInOtherNamespace_ThenRejected": {
+			namespace:      "team-b",
+			providerConfig: "minio",
+			wantErr:        "LDAPProvider team-a/ad already configures LDAP",
+		},
+		"GivenOtherProviderConfig_ThenAllowed": {
+			namespace:      "team-b",
+			providerConfig: "other",
+		},
+		"GivenSameResource_ThenAllowed": {
+			namespace:      "team-a",
+			providerConfig: "minio",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			validator := &Validator{log: testr.New(t), kube: fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing.DeepCopy()).Build()}
+			provider := newLDAPProvider(adParams())
+			provider.SetNamespace(tc.namespace)
+			provider.Spec.ProviderConfigReference = &xpv1.ProviderConfigReference{Name: tc.providerConfig}
+
+			_, err := validator.ValidateCreate(context.Background(), provider)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
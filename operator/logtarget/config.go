@@ -0,0 +1,132 @@
+package logtarget
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/rossigee/provider-minio/operator/minioutil"
+)
+
+const (
+	enableKey    = "enable"
+	authTokenKey = "auth_token"
+	batchSizeKey = "batch_size"
+	queueDirKey  = "queue_dir"
+	queueSizeKey = "queue_size"
+)
+
+// targetKeys holds the configuration parameters of a target type that are set by dedicated fields.
+type targetKeys struct {
+	endpoint   string
+	clientCert string
+	clientKey  string
+	webhook    bool
+}
+
+// keysByType maps the target types to their configuration parameters.
+var keysByType = map[string]targetKeys{
+	madmin.AuditWebhookSubSys:  {endpoint: "endpoint", clientCert: "client_cert", clientKey: "client_key", webhook: true},
+	madmin.LoggerWebhookSubSys: {endpoint: "endpoint", clientCert: "client_cert", clientKey: "client_key", webhook: true},
+	madmin.AuditKafkaSubSys:    {endpoint: "brokers", clientCert: "client_tls_cert", clientKey: "client_tls_key"},
+}
+
+// configKey returns the key of the target in the server configuration, e.g. `audit_webhook:siem`.
+func configKey(target *miniov1beta1.LogTarget) string {
+	return target.Spec.ForProvider.Type + madmin.SubSystemSeparator + target.GetTargetName()
+}
+
+// reservedKeys returns the configuration parameters that are set by dedicated fields of the target type.
+func reservedKeys(targetType string) []string {
+	keys := keysByType[targetType]
+	reserved := []string{enableKey, keys.endpoint, keys.clientCert, keys.clientKey, queueDirKey, queueSizeKey}
+	if keys.webhook {
+		reserved = append(reserved, authTokenKey, batchSizeKey)
+	}
+	return reserved
+}
+
+// plainConfig returns the configuration parameters of the target that are not read from Secrets.
+func plainConfig(params miniov1beta1.LogTargetParameters) map[string]string {
+	keys := keysByType[params.Type]
+	config := map[string]string{}
+	for key, value := range params.Config {
+		config[key] = value
+	}
+	config[enableKey] = "on"
+	config[keys.endpoint] = params.Endpoint
+	if params.ClientCert != "" {
+		config[keys.clientCert] = params.ClientCert
+		config[keys.clientKey] = params.ClientKey
+	}
+	if params.BatchSize != nil {
+		config[batchSizeKey] = strconv.FormatInt(*params.BatchSize, 10)
+	}
+	if params.QueueDir != "" {
+		config[queueDirKey] = params.QueueDir
+	}
+	if params.QueueSize != nil {
+		config[queueSizeKey] = strconv.FormatInt(*params.QueueSize, 10)
+	}
+	return config
+}
+
+// secretRefs returns the configuration parameters of the target that are read from Secrets.
+func secretRefs(params miniov1beta1.LogTargetParameters) map[string]xpv1.LocalSecretKeySelector {
+	refs := map[string]xpv1.LocalSecretKeySelector{}
+	for key, ref := range params.SecretConfig {
+		refs[key] = ref
+	}
+	if params.AuthTokenSecretRef != nil {
+		refs[authTokenKey] = *params.AuthTokenSecretRef
+	}
+	return refs
+}
+
+// configKV returns the configuration line that sets the given parameters of the target.
+func configKV(target *miniov1beta1.LogTarget, config map[string]string) string {
+	return strings.Join(append([]string{configKey(target)}, minioutil.ConfigPairs(config)...), madmin.KvSpaceSeparator)
+}
+
+// findTarget returns the configuration of the target in the server configuration output, or nil if it is not configured.
+func findTarget(target *miniov1beta1.LogTarget, output []byte) (*madmin.SubsysConfig, error) {
+	configs, err := madmin.ParseServerConfigOutput(string(output))
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse server configuration: %w", err)
+	}
+	for i := range configs {
+		if configs[i].SubSystem == target.Spec.ForProvider.Type && configs[i].Target == target.GetTargetName() {
+			return &configs[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// isConfigured returns true if the target is enabled and has an endpoint.
+func isConfigured(target *miniov1beta1.LogTarget, observed *madmin.SubsysConfig) bool {
+	if observed == nil {
+		return false
+	}
+	if enabled, ok := observed.Lookup(enableKey); ok && enabled == "off" {
+		return false
+	}
+	endpoint, _ := observed.Lookup(keysByType[target.Spec.ForProvider.Type].endpoint)
+	return endpoint != ""
+}
+
+// isConfigUpToDate returns true if the observed configuration has all the parameters that are not read from Secrets.
+// Parameters that are not set in the spec are left at the values of the server.
+func isConfigUpToDate(target *miniov1beta1.LogTarget, observed *madmin.SubsysConfig) bool {
+	for key, value := range plainConfig(target.Spec.ForProvider) {
+		if key == enableKey {
+			continue
+		}
+		if observedValue, _ := observed.Lookup(key); observedValue != value {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,78 @@
+package logtarget
+
+import (
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func newLogTarget(params miniov1beta1.LogTargetParameters) *miniov1beta1.LogTarget {
+	return &miniov1beta1.LogTarget{
+		ObjectMeta: metav1.ObjectMeta{Name: "siem", Namespace: "default"},
+		Spec: miniov1beta1.LogTargetSpec{
+			ManagedResourceSpec: xpv1.ManagedResourceSpec{ProviderConfigReference: &xpv1.ProviderConfigReference{Name: "minio"}},
+			ForProvider:         params,
+		},
+	}
+}
+
+func TestConfigKV_Webhook(t *testing.T) {
+	target := newLogTarget(miniov1beta1.LogTargetParameters{
+		Type:       "audit_webhook",
+		Endpoint:   "https://siem.example.com/audit",
+		ClientCert: "/certs/client.crt",
+		ClientKey:  "/certs/client.key",
+		BatchSize:  ptr.To(int64(100)),
+		QueueDir:   "/data/audit",
+		QueueSize:  ptr.To(int64(10000)),
+	})
+
+	assert.Equal(t, "audit_webhook:siem", configKey(target))
+	assert.Equal(t,
+		`audit_webhook:siem batch_size="100" client_cert="/certs/client.crt" client_key="/certs/client.key" enable="on" endpoint="https://siem.example.com/audit" queue_dir="/data/audit" queue_size="10000"`,
+		configKV(target, plainConfig(target.Spec.ForProvider)))
+}
+
+func TestConfigKV_Kafka(t *testing.T) {
+	target := newLogTarget(miniov1beta1.LogTargetParameters{
+		Type:       "audit_kafka",
+		TargetName: "audit",
+		Endpoint:   "kafka-0:9092,kafka-1:9092",
+		ClientCert: "/certs/client.crt",
+		ClientKey:  "/certs/client.key",
+		Config:     map[string]string{"topic": "minio-audit"},
+	})
+
+	assert.Equal(t, "audit_kafka:audit", configKey(target))
+	assert.Equal(t,
+		`audit_kafka:audit brokers="kafka-0:9092,kafka-1:9092" client_tls_cert="/certs/client.crt" client_tls_key="/certs/client.key" enable="on" topic="minio-audit"`,
+		configKV(target, plainConfig(target.Spec.ForProvider)))
+}
+
+func TestObserveConfig(t *testing.T) {
+	target := newLogTarget(miniov1beta1.LogTargetParameters{
+		Type:      "logger_webhook",
+		Endpoint:  "https://logs.example.com",
+		BatchSize: ptr.To(int64(10)),
+	})
+
+	output := []byte(`logger_webhook:siem endpoint=https://logs.example.com auth_token= batch_size=10 queue_size=100000 queue_dir=
+logger_webhook:other endpoint=https://other.example.com`)
+	observed, err := findTarget(target, output)
+	require.NoError(t, err)
+	assert.True(t, isConfigured(target, observed))
+	assert.True(t, isConfigUpToDate(target, observed))
+
+	target.Spec.ForProvider.BatchSize = ptr.To(int64(20))
+	assert.False(t, isConfigUpToDate(target, observed))
+
+	target.Spec.ForProvider.TargetName = "missing"
+	observed, err = findTarget(target, output)
+	require.NoError(t, err)
+	assert.False(t, isConfigured(target, observed))
+}
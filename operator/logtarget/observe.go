@@ -0,0 +1,54 @@
+package logtarget
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/rossigee/provider-minio/operator/minioutil"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (c *logTargetClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("observing resource")
+
+	target, ok := mg.(*miniov1beta1.LogTarget)
+	if !ok {
+		return managed.ExternalObservation{}, errNotLogTarget
+	}
+
+	output, err := c.ma.GetConfigKV(ctx, target.Spec.ForProvider.Type)
+	if err != nil {
+		return managed.ExternalObservation{}, fmt.Errorf("cannot get log target configuration: %w", err)
+	}
+
+	observed, err := findTarget(target, output)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if !isConfigured(target, observed) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	c.observeState(ctx, target)
+
+	// The server redacts the auth token and client key of the target, they are
+	// re-applied when the resource version of one of their Secrets changes.
+	versions, err := minioutil.SecretVersions(ctx, c.kube, target.GetNamespace(), secretRefs(target.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if !isConfigUpToDate(target, observed) || !maps.Equal(versions, target.Status.AtProvider.ObservedSecretVersions) {
+		target.SetConditions(miniov1beta1.Updating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	target.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
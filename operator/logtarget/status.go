@@ -0,0 +1,81 @@
+package logtarget
+
+import (
+	"context"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// targetState returns the state of the target reported in the server info, or an empty string if the target is not reported.
+// The target is offline if any status entry reports it as offline.
+func targetState(target *miniov1beta1.LogTarget, info madmin.InfoMessage) string {
+	statuses := []map[string]madmin.Status{}
+	if target.Spec.ForProvider.Type == madmin.LoggerWebhookSubSys {
+		for _, logger := range info.Services.Logger {
+			statuses = append(statuses, logger)
+		}
+	} else {
+		for _, audit := range info.Services.Audit {
+			statuses = append(statuses, audit)
+		}
+	}
+
+	state := ""
+	for _, status := range statuses {
+		for name, s := range status {
+			if !matchesTarget(name, target) {
+				continue
+			}
+			if s.Status != string(madmin.ItemOnline) {
+				return string(madmin.ItemOffline)
+			}
+			state = string(madmin.ItemOnline)
+		}
+	}
+	return state
+}
+
+// matchesTarget returns true if the name of a status entry refers to the target.
+// Depending on the version, the server names the entries after the target name with a prefix
+// like `audit-` or `audit_webhook:`, or after the endpoint of the target.
+func matchesTarget(name string, target *miniov1beta1.LogTarget) bool {
+	targetName := target.GetTargetName()
+	if name == targetName || name == target.Spec.ForProvider.Endpoint {
+		return true
+	}
+	prefix, found := strings.CutSuffix(name, targetName)
+	return found && strings.ContainsAny(prefix[len(prefix)-1:], "-:_")
+}
+
+// observeState sets the state of the target and the TargetOnline condition from the server info.
+// The state is best effort, it is unknown if the server info cannot be retrieved.
+func (c *logTargetClient) observeState(ctx context.Context, target *miniov1beta1.LogTarget) {
+	info, err := c.ma.ServerInfo(ctx)
+	if err != nil {
+		ctrl.LoggerFrom(ctx).V(1).Info("cannot get server info, skipping target state", "error", err.Error())
+		target.Status.AtProvider.State = ""
+		target.SetConditions(miniov1beta1.TargetStatusUnknown("Cannot get the server info: " + err.Error()))
+		return
+	}
+
+	target.Status.AtProvider.State = targetState(target, info)
+	switch target.Status.AtProvider.State {
+	case string(madmin.ItemOnline):
+		target.SetConditions(miniov1beta1.TargetOnline())
+	case string(madmin.ItemOffline):
+		if target.GetCondition(miniov1beta1.TypeTargetOnline).Reason != miniov1beta1.TargetOffline().Reason {
+			c.recorder.Event(target, event.Event{
+				Type:    event.TypeWarning,
+				Reason:  "TargetOffline",
+				Message: "The MinIO server reports the log target as offline",
+			})
+		}
+		target.SetConditions(miniov1beta1.TargetOffline())
+	default:
+		target.SetConditions(miniov1beta1.TargetStatusUnknown("The server does not report a status for the target"))
+	}
+}
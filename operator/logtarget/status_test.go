@@ -0,0 +1,47 @@
+package logtarget
+
+import (
+	"testing"
+
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTargetState(t *testing.T) {
+	online := madmin.Status{Status: "online"}
+	offline := madmin.Status{Status: "offline"}
+
+	tests := map[string]struct {
+		targetType    string
+		services      madmin.Services
+		expectedState string
+	}{
+		"Online": {
+			targetType:    "audit_webhook",
+			services:      madmin.Services{Audit: []madmin.Audit{{"audit-siem": online}, {"audit-other": offline}}},
+			expectedState: "online",
+		},
+		"Offline": {
+			targetType:    "audit_kafka",
+			services:      madmin.Services{Audit: []madmin.Audit{{"audit_kafka:siem": online}, {"audit_kafka:siem": offline}}},
+			expectedState: "offline",
+		},
+		"Logger": {
+			targetType:    "logger_webhook",
+			services:      madmin.Services{Audit: []madmin.Audit{{"siem": offline}}, Logger: []madmin.Logger{{"logger-siem": online}}},
+			expectedState: "online",
+		},
+		"NotReported": {
+			targetType: "audit_webhook",
+			services:   madmin.Services{Audit: []madmin.Audit{{"audit-notsiem": offline}}},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			target := newLogTarget(miniov1beta1.LogTargetParameters{Type: tc.targetType, Endpoint: "https://siem.example.com"})
+			assert.Equal(t, tc.expectedState, targetState(target, madmin.InfoMessage{Services: tc.services}))
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package logtarget
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (c *logTargetClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("updating resource")
+
+	target, ok := mg.(*miniov1beta1.LogTarget)
+	if !ok {
+		return managed.ExternalUpdate{}, errNotLogTarget
+	}
+
+	if err := c.applyConfig(ctx, target); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	c.recorder.Event(target, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Updated",
+		Message: "Log target successfully updated",
+	})
+	return managed.ExternalUpdate{}, nil
+}
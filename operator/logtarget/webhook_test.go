@@ -0,0 +1,74 @@
+package logtarget
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	"github.com/go-logr/logr"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+)
+
+func TestValidateTarget(t *testing.T) {
+	token := &xpv1.LocalSecretKeySelector{LocalSecretReference: xpv1.LocalSecretReference{Name: "siem"}, Key: "token"}
+
+	tests := map[string]struct {
+		params  miniov1beta1.LogTargetParameters
+		wantErr string
+	}{
+		"Webhook": {
+			params: miniov1beta1.LogTargetParameters{Type: "audit_webhook", Endpoint: "https://siem.example.com", AuthTokenSecretRef: token, BatchSize: ptr.To(int64(10))},
+		},
+		"Kafka": {
+			params: miniov1beta1.LogTargetParameters{Type: "audit_kafka", Endpoint: "kafka-0:9092", Config: map[string]string{"topic": "audit"}},
+		},
+		"UnsupportedType": {
+			params:  miniov1beta1.LogTargetParameters{Type: "notify_webhook", Endpoint: "https://siem.example.com"},
+			wantErr: "Unsupported value",
+		},
+		"InvalidWebhookEndpoint": {
+			params:  miniov1beta1.LogTargetParameters{Type: "logger_webhook", Endpoint: "siem.example.com"},
+			wantErr: "http or https URL",
+		},
+		"KafkaAuthToken": {
+			params:  miniov1beta1.LogTargetParameters{Type: "audit_kafka", Endpoint: "kafka-0:9092", AuthTokenSecretRef: token},
+			wantErr: "only supported by webhook targets",
+		},
+		"ClientCertWithoutKey": {
+			params:  miniov1beta1.LogTargetParameters{Type: "audit_webhook", Endpoint: "https://siem.example.com", ClientCert: "/certs/client.crt"},
+			wantErr: "must be set together",
+		},
+		"ReservedKey": {
+			params:  miniov1beta1.LogTargetParameters{Type: "audit_webhook", Endpoint: "https://siem.example.com", Config: map[string]string{"batch_size": "10"}},
+			wantErr: "set by a dedicated field",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateTarget(newLogTarget(tc.params))
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestValidateUpdate_Immutable(t *testing.T) {
+	v := &Validator{log: logr.Discard()}
+	oldTarget := newLogTarget(miniov1beta1.LogTargetParameters{Type: "audit_webhook", Endpoint: "https://siem.example.com"})
+
+	newTarget := oldTarget.DeepCopy()
+	newTarget.Spec.ForProvider.Endpoint = "https://siem2.example.com"
+	_, err := v.ValidateUpdate(context.Background(), oldTarget, newTarget)
+	assert.NoError(t, err)
+
+	newTarget = oldTarget.DeepCopy()
+	newTarget.Spec.ForProvider.TargetName = "other"
+	_, err = v.ValidateUpdate(context.Background(), oldTarget, newTarget)
+	assert.ErrorContains(t, err, "Changing the target name is not allowed")
+}
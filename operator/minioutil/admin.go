@@ -9,13 +9,16 @@ import (
 	"github.com/minio/madmin-go/v3"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 	providerv1 "github.com/rossigee/provider-minio/apis/provider/v1"
+	"github.com/rossigee/provider-minio/internal/metrics"
+	"github.com/rossigee/provider-minio/internal/tracing"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // NewMinioAdmin returns a new minio admin client that can manage users and IAM.
 // It can be used to assign a policy to a user.
-func NewMinioAdmin(ctx context.Context, c client.Client, config *providerv1.ProviderConfig) (*madmin.AdminClient, error) {
+// The calls made by the client are recorded as metrics labelled with the given managed resource kind.
+func NewMinioAdmin(ctx context.Context, c client.Client, config *providerv1.ProviderConfig, kind string) (*madmin.AdminClient, error) {
 	secret := &corev1.Secret{}
 	key := client.ObjectKey{Name: config.Spec.Credentials.APISecretRef.Name, Namespace: config.Spec.Credentials.APISecretRef.Namespace}
 	err := c.Get(ctx, key, secret)
@@ -28,13 +31,7 @@ func NewMinioAdmin(ctx context.Context, c client.Client, config *providerv1.Prov
 		return nil, err
 	}
 
-	adminClient, err := madmin.NewWithOptions(parsed.Host, &madmin.Options{
-		Creds:  credentials.NewStaticV4(string(secret.Data[MinioIDKey]), string(secret.Data[MinioSecretKey]), ""),
-		Secure: IsTLSEnabled(parsed),
-	})
-	if err != nil {
-		return nil, err
-	}
+	transport := madmin.DefaultTransport(IsTLSEnabled(parsed))
 
 	// Apply custom TLS configuration if provided
 	if config.Spec.TLS != nil {
@@ -44,11 +41,14 @@ func NewMinioAdmin(ctx context.Context, c client.Client, config *providerv1.Prov
 		}
 
 		// Create a custom transport with the TLS config
-		transport := &http.Transport{
+		transport = &http.Transport{
 			TLSClientConfig: tlsConfig,
 		}
-		adminClient.SetCustomTransport(transport)
 	}
 
-	return adminClient, nil
+	return madmin.NewWithOptions(parsed.Host, &madmin.Options{
+		Creds:     credentials.NewStaticV4(string(secret.Data[MinioIDKey]), string(secret.Data[MinioSecretKey]), ""),
+		Secure:    IsTLSEnabled(parsed),
+		Transport: tracing.NewTransport(metrics.NewTransport(transport, kind, config.GetName())),
+	})
 }
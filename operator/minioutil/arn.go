@@ -0,0 +1,27 @@
+package minioutil
+
+import (
+	"github.com/minio/minio-go/v7/pkg/notification"
+)
+
+// HasTargetARN returns true if one of the advertised ARNs refers to the same notification target as arn.
+// The server advertises the ARNs of the targets it has loaded in the SQSARN field of its server info.
+// The region is only compared if both ARNs have one, as MinIO accepts ARNs without a region.
+func HasTargetARN(advertised []string, arn notification.Arn) bool {
+	for _, candidate := range advertised {
+		parsed, err := notification.NewArnFromString(candidate)
+		if err != nil {
+			continue
+		}
+		if arn.Region != "" && parsed.Region != "" && arn.Region != parsed.Region {
+			continue
+		}
+		if arn.Partition == parsed.Partition &&
+			arn.Service == parsed.Service &&
+			arn.AccountID == parsed.AccountID &&
+			arn.Resource == parsed.Resource {
+			return true
+		}
+	}
+	return false
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/rossigee/provider-minio/apis/common"
 	providerv1 "github.com/rossigee/provider-minio/apis/provider/v1"
+	"github.com/rossigee/provider-minio/internal/metrics"
+	"github.com/rossigee/provider-minio/internal/tracing"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -23,7 +25,8 @@ const (
 )
 
 // NewMinioClient returns a new minio client according to the given provider config.
-func NewMinioClient(ctx context.Context, c client.Client, config *providerv1.ProviderConfig) (*minio.Client, error) {
+// The calls made by the client are recorded as metrics labelled with the given managed resource kind.
+func NewMinioClient(ctx context.Context, c client.Client, config *providerv1.ProviderConfig, kind string) (*minio.Client, error) {
 	secret := &corev1.Secret{}
 	key := client.ObjectKey{Name: config.Spec.Credentials.APISecretRef.Name, Namespace: config.Spec.Credentials.APISecretRef.Namespace}
 	err := c.Get(ctx, key, secret)
@@ -36,9 +39,9 @@ func NewMinioClient(ctx context.Context, c client.Client, config *providerv1.Pro
 		return nil, err
 	}
 
-	options := &minio.Options{
-		Creds:  credentials.NewStaticV4(string(secret.Data[MinioIDKey]), string(secret.Data[MinioSecretKey]), ""),
-		Secure: IsTLSEnabled(parsed),
+	transport, err := minio.DefaultTransport(IsTLSEnabled(parsed))
+	if err != nil {
+		return nil, err
 	}
 
 	// Apply custom TLS configuration if provided
@@ -50,10 +53,15 @@ func NewMinioClient(ctx context.Context, c client.Client, config *providerv1.Pro
 		}
 
 		// Create a custom transport with the TLS config
-		transport := &http.Transport{
+		transport = &http.Transport{
 			TLSClientConfig: tlsConfig,
 		}
-		options.Transport = transport
+	}
+
+	options := &minio.Options{
+		Creds:     credentials.NewStaticV4(string(secret.Data[MinioIDKey]), string(secret.Data[MinioSecretKey]), ""),
+		Secure:    IsTLSEnabled(parsed),
+		Transport: tracing.NewTransport(metrics.NewTransport(transport, kind, config.GetName())),
 	}
 
 	return minio.New(parsed.Host, options)
@@ -393,7 +393,7 @@ func TestNewMinioClient(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			client, err := NewMinioClient(context.Background(), tt.setupClient(), tt.config)
+			client, err := NewMinioClient(context.Background(), tt.setupClient(), tt.config, "Bucket")
 			if tt.wantErr {
 				assert.Error(t, err)
 				return
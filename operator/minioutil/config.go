@@ -0,0 +1,92 @@
+package minioutil
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	"github.com/minio/madmin-go/v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetSecretValue returns the value of the referenced Secret key in the given namespace together with the resource version of the Secret.
+// The value is written into a configuration line of the server, so it must not contain double quotes.
+func GetSecretValue(ctx context.Context, c client.Client, namespace string, ref xpv1.LocalSecretKeySelector) (string, string, error) {
+	secret := corev1.Secret{}
+	err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, &secret)
+	if err != nil {
+		return "", "", fmt.Errorf("cannot get secret %q: %w", ref.Name, err)
+	}
+
+	value, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", "", fmt.Errorf("secret %q has no value for key %q", ref.Name, ref.Key)
+	}
+	if strings.Contains(string(value), madmin.KvDoubleQuote) {
+		return "", "", fmt.Errorf("value of key %q in secret %q must not contain double quotes", ref.Key, ref.Name)
+	}
+
+	return string(value), secret.GetResourceVersion(), nil
+}
+
+// SecretVersions returns the resource versions of the referenced Secrets by configuration parameter.
+func SecretVersions(ctx context.Context, c client.Client, namespace string, refs map[string]xpv1.LocalSecretKeySelector) (map[string]string, error) {
+	versions := map[string]string{}
+	for key, ref := range refs {
+		_, version, err := GetSecretValue(ctx, c, namespace, ref)
+		if err != nil {
+			return nil, err
+		}
+		versions[key] = version
+	}
+	return versions, nil
+}
+
+// ConfigPairs returns the parameters as `key="value"` pairs sorted by key,
+// the format of a configuration line of the server and of `mc admin idp ... add`.
+func ConfigPairs(config map[string]string) []string {
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+madmin.KvSeparator+madmin.KvDoubleQuote+config[key]+madmin.KvDoubleQuote)
+	}
+	return pairs
+}
+
+// IDPConfigValues returns the configuration parameters of an identity provider together with the parameters
+// that are set by environment variables on the server.
+func IDPConfigValues(idpConfig madmin.IDPConfig) (map[string]string, []string) {
+	config := map[string]string{}
+	envKeys := []string{}
+	for _, info := range idpConfig.Info {
+		if !info.IsCfg {
+			continue
+		}
+		config[info.Key] = info.Value
+		if info.IsEnv {
+			envKeys = append(envKeys, info.Key)
+		}
+	}
+	return config, envKeys
+}
+
+// SplitList returns the sorted, trimmed elements of a comma separated list.
+func SplitList(value string) []string {
+	elements := []string{}
+	for _, element := range strings.Split(value, ",") {
+		if element = strings.TrimSpace(element); element != "" {
+			elements = append(elements, element)
+		}
+	}
+	sort.Strings(elements)
+	return elements
+}
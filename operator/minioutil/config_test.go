@@ -0,0 +1,66 @@
+package minioutil
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	"github.com/minio/madmin-go/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSecretVersions(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "webhook", Namespace: "default"},
+		Data:       map[string][]byte{"token": []byte("s3cr3t"), "quoted": []byte(`a"b`)},
+	}
+	kube := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+	ref := xpv1.LocalSecretKeySelector{LocalSecretReference: xpv1.LocalSecretReference{Name: "webhook"}, Key: "token"}
+
+	value, version, err := GetSecretValue(context.Background(), kube, "default", ref)
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", value)
+	assert.Equal(t, "999", version)
+
+	versions, err := SecretVersions(context.Background(), kube, "default", map[string]xpv1.LocalSecretKeySelector{"auth_token": ref})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"auth_token": "999"}, versions)
+
+	ref.Key = "quoted"
+	_, err = SecretVersions(context.Background(), kube, "default", map[string]xpv1.LocalSecretKeySelector{"auth_token": ref})
+	assert.ErrorContains(t, err, "must not contain double quotes")
+
+	ref.Key = "missing"
+	_, err = SecretVersions(context.Background(), kube, "default", map[string]xpv1.LocalSecretKeySelector{"auth_token": ref})
+	assert.ErrorContains(t, err, "has no value for key")
+
+	_, _, err = GetSecretValue(context.Background(), kube, "other", ref)
+	assert.ErrorContains(t, err, "cannot get secret")
+}
+
+func TestConfigPairs(t *testing.T) {
+	assert.Empty(t, ConfigPairs(nil))
+	assert.Equal(t, []string{`a="1"`, `b="two words"`}, ConfigPairs(map[string]string{"b": "two words", "a": "1"}))
+}
+
+func TestIDPConfigValues(t *testing.T) {
+	config, envKeys := IDPConfigValues(madmin.IDPConfig{Info: []madmin.IDPCfgInfo{
+		{Key: "server_addr", Value: "ldap.example.com:636", IsCfg: true},
+		{Key: "user_dn_search_filter", Value: "(uid=%s)", IsCfg: true, IsEnv: true},
+		{Key: "roles", Value: "", IsCfg: false},
+	}})
+	assert.Equal(t, map[string]string{"server_addr": "ldap.example.com:636", "user_dn_search_filter": "(uid=%s)"}, config)
+	assert.Equal(t, []string{"user_dn_search_filter"}, envKeys)
+}
+
+func TestSplitList(t *testing.T) {
+	assert.Empty(t, SplitList(""))
+	assert.Equal(t, []string{"a", "b"}, SplitList(" b, a ,,"))
+}
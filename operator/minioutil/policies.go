@@ -0,0 +1,32 @@
+package minioutil
+
+import "strings"
+
+// ParsePolicies splits the comma separated policy list MinIO reports for a user.
+func ParsePolicies(policyName string) []string {
+	if policyName == "" {
+		return nil
+	}
+	return strings.Split(policyName, ",")
+}
+
+// DiffSets returns the elements that need to be added and removed to get from the current to the desired set.
+func DiffSets(current, desired []string) (add, remove []string) {
+	currentSet := make(map[string]bool, len(current))
+	for _, p := range current {
+		currentSet[p] = true
+	}
+	desiredSet := make(map[string]bool, len(desired))
+	for _, p := range desired {
+		if !desiredSet[p] && !currentSet[p] {
+			add = append(add, p)
+		}
+		desiredSet[p] = true
+	}
+	for _, p := range current {
+		if !desiredSet[p] {
+			remove = append(remove, p)
+		}
+	}
+	return add, remove
+}
@@ -0,0 +1,55 @@
+package minioutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePolicies(t *testing.T) {
+	assert.Nil(t, ParsePolicies(""))
+	assert.Equal(t, []string{"a"}, ParsePolicies("a"))
+	assert.Equal(t, []string{"a", "b"}, ParsePolicies("a,b"))
+}
+
+func TestDiffSets(t *testing.T) {
+	tests := map[string]struct {
+		current    []string
+		desired    []string
+		wantAttach []string
+		wantDetach []string
+	}{
+		"GivenBothEmpty_ThenNoChanges": {},
+		"GivenSameSetInDifferentOrder_ThenNoChanges": {
+			current: []string{"b", "a"},
+			desired: []string{"a", "b"},
+		},
+		"GivenNewPolicy_ThenAttachOnlyNew": {
+			current:    []string{"a"},
+			desired:    []string{"a", "b"},
+			wantAttach: []string{"b"},
+		},
+		"GivenRemovedPolicy_ThenDetachOnlyRemoved": {
+			current:    []string{"a", "b"},
+			desired:    []string{"b"},
+			wantDetach: []string{"a"},
+		},
+		"GivenReplacedPolicy_ThenAttachAndDetach": {
+			current:    []string{"a", "b"},
+			desired:    []string{"b", "c"},
+			wantAttach: []string{"c"},
+			wantDetach: []string{"a"},
+		},
+		"GivenDuplicateDesiredPolicy_ThenAttachOnce": {
+			desired:    []string{"a", "a"},
+			wantAttach: []string{"a"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			attach, detach := DiffSets(tc.current, tc.desired)
+			assert.Equal(t, tc.wantAttach, attach)
+			assert.Equal(t, tc.wantDetach, detach)
+		})
+	}
+}
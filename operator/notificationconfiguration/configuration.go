@@ -0,0 +1,288 @@
+package notificationconfiguration
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/minio/minio-go/v7/pkg/notification"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+)
+
+// ownerPrefix returns the ownership marker that prefixes the IDs of all bucket notification
+// entries written by the resource, so that resources sharing a bucket leave each other's entries alone.
+func ownerPrefix(cr *miniov1beta1.NotificationConfiguration) string {
+	return fmt.Sprintf("crossplane:%s/%s:", cr.GetNamespace(), cr.GetName())
+}
+
+// entryID returns the ID of the bucket notification entry of the rule.
+func entryID(cr *miniov1beta1.NotificationConfiguration, rule miniov1beta1.NotificationRule) string {
+	return ownerPrefix(cr) + rule.ID
+}
+
+// desiredRules returns the rules of the resource, including the ones defined by the
+// top-level webhook, queue and topic configurations.
+func desiredRules(params miniov1beta1.NotificationConfigurationParameters) []miniov1beta1.NotificationRule {
+	rules := []miniov1beta1.NotificationRule{}
+	if params.WebhookConfiguration != nil {
+		rules = append(rules, miniov1beta1.NotificationRule{
+			ID:                   params.WebhookConfiguration.ID,
+			WebhookConfiguration: params.WebhookConfiguration,
+			Events:               params.Events,
+			Filter:               params.Filter,
+		})
+	}
+	if params.QueueConfiguration != nil {
+		rules = append(rules, miniov1beta1.NotificationRule{
+			ID:       params.QueueConfiguration.ID,
+			QueueArn: params.QueueConfiguration.QueueArn,
+			Events:   params.Events,
+			Filter:   params.Filter,
+		})
+	}
+	if params.TopicConfiguration != nil {
+		rules = append(rules, miniov1beta1.NotificationRule{
+			ID:       params.TopicConfiguration.ID,
+			TopicArn: params.TopicConfiguration.TopicArn,
+			Events:   params.Events,
+			Filter:   params.Filter,
+		})
+	}
+	return append(rules, params.Rules...)
+}
+
+// hasTarget returns true if at least one notification target is configured.
+func hasTarget(params miniov1beta1.NotificationConfigurationParameters) bool {
+	return len(desiredRules(params)) > 0
+}
+
+// configurationID returns the ID of the first rule.
+func configurationID(params miniov1beta1.NotificationConfigurationParameters) string {
+	rules := desiredRules(params)
+	if len(rules) == 0 {
+		return ""
+	}
+	return rules[0].ID
+}
+
+// webhookARN returns the ARN identifying the webhook in the bucket notification.
+func webhookARN(webhookConfig *miniov1beta1.WebhookConfiguration) notification.Arn {
+	// ARN format: arn:minio:sqs::<webhook-id>:webhook
+	return notification.NewArn("minio", "sqs", "", webhookConfig.ID, "webhook")
+}
+
+// newConfig returns the notification config with the given ID and the events and filter of the rule.
+func newConfig(rule miniov1beta1.NotificationRule, id string) notification.Config {
+	config := notification.Config{ID: id}
+
+	for _, event := range rule.Events {
+		config.Events = append(config.Events, notification.EventType(event))
+	}
+
+	// Bucket notifications only support S3 key filters (prefix and suffix).
+	// Neither MinIO nor the S3 API filter notifications by object tags or metadata.
+	if filter := rule.Filter; filter != nil && filter.Key != nil {
+		config.Filter = &notification.Filter{
+			S3Key: notification.S3Key{
+				FilterRules: []notification.FilterRule{},
+			},
+		}
+		for _, filterRule := range filter.Key.FilterRules {
+			config.Filter.S3Key.FilterRules = append(
+				config.Filter.S3Key.FilterRules,
+				notification.FilterRule{
+					Name:  filterRule.Name,
+					Value: filterRule.Value,
+				},
+			)
+		}
+	}
+
+	return config
+}
+
+// addRules appends the entries of all rules to the bucket notification.
+func addRules(cr *miniov1beta1.NotificationConfiguration, config *notification.Configuration) {
+	for _, rule := range desiredRules(cr.Spec.ForProvider) {
+		entry := newConfig(rule, entryID(cr, rule))
+		switch {
+		case rule.WebhookConfiguration != nil:
+			// In MinIO, webhooks are configured using LambdaConfig with the webhook URL as the Lambda field.
+			entry.Arn = webhookARN(rule.WebhookConfiguration)
+			config.LambdaConfigs = append(config.LambdaConfigs, notification.LambdaConfig{Config: entry, Lambda: rule.WebhookConfiguration.Endpoint})
+		case rule.QueueArn != "":
+			config.QueueConfigs = append(config.QueueConfigs, notification.QueueConfig{Config: entry, Queue: rule.QueueArn})
+		case rule.TopicArn != "":
+			config.TopicConfigs = append(config.TopicConfigs, notification.TopicConfig{Config: entry, Topic: rule.TopicArn})
+		}
+	}
+}
+
+// isOwned returns true if the bucket notification entry was written by the resource.
+func isOwned(cr *miniov1beta1.NotificationConfiguration, id string) bool {
+	return strings.HasPrefix(id, ownerPrefix(cr))
+}
+
+// isOwnedWebhook returns true if the webhook entry was written by the resource.
+// Webhooks written before ownership markers were introduced have no ID and are
+// identified by the ARN and endpoint of the top-level webhook configuration.
+func isOwnedWebhook(cr *miniov1beta1.NotificationConfiguration, lambda notification.LambdaConfig) bool {
+	if isOwned(cr, lambda.ID) {
+		return true
+	}
+	webhookConfig := cr.Spec.ForProvider.WebhookConfiguration
+	return lambda.ID == "" && webhookConfig != nil &&
+		lambda.Arn == webhookARN(webhookConfig) && lambda.Lambda == webhookConfig.Endpoint
+}
+
+// removeOwned removes all entries written by the resource from the bucket notification,
+// leaving the entries of other resources untouched.
+func removeOwned(cr *miniov1beta1.NotificationConfiguration, config *notification.Configuration) {
+	lambdas := []notification.LambdaConfig{}
+	for _, lambda := range config.LambdaConfigs {
+		if !isOwnedWebhook(cr, lambda) {
+			lambdas = append(lambdas, lambda)
+		}
+	}
+	config.LambdaConfigs = lambdas
+
+	queues := []notification.QueueConfig{}
+	for _, queue := range config.QueueConfigs {
+		if !isOwned(cr, queue.ID) {
+			queues = append(queues, queue)
+		}
+	}
+	config.QueueConfigs = queues
+
+	topics := []notification.TopicConfig{}
+	for _, topic := range config.TopicConfigs {
+		if !isOwned(cr, topic.ID) {
+			topics = append(topics, topic)
+		}
+	}
+	config.TopicConfigs = topics
+}
+
+// observeRules returns whether any entry of the resource exists in the bucket notification
+// and whether the entries of the resource match its rules exactly.
+func observeRules(cr *miniov1beta1.NotificationConfiguration, config *notification.Configuration) (exists bool, upToDate bool) {
+	// Collect the owned entries by ID together with their target
+	type ownedEntry struct {
+		config notification.Config
+		target string
+	}
+	owned := map[string]ownedEntry{}
+	for _, lambda := range config.LambdaConfigs {
+		if isOwnedWebhook(cr, lambda) {
+			owned[lambda.ID] = ownedEntry{config: lambda.Config, target: "webhook:" + lambda.Lambda}
+		}
+	}
+	for _, queue := range config.QueueConfigs {
+		if isOwned(cr, queue.ID) {
+			owned[queue.ID] = ownedEntry{config: queue.Config, target: "queue:" + queue.Queue}
+		}
+	}
+	for _, topic := range config.TopicConfigs {
+		if isOwned(cr, topic.ID) {
+			owned[topic.ID] = ownedEntry{config: topic.Config, target: "topic:" + topic.Topic}
+		}
+	}
+	if len(owned) == 0 {
+		return false, false
+	}
+
+	rules := desiredRules(cr.Spec.ForProvider)
+	if len(owned) != len(rules) {
+		return true, false
+	}
+	for _, rule := range rules {
+		entry, found := owned[entryID(cr, rule)]
+		if !found || entry.target != ruleTarget(rule) || !isConfigUpToDate(rule, &entry.config) {
+			return true, false
+		}
+	}
+	return true, true
+}
+
+// ruleTarget returns a string identifying the target of the rule.
+func ruleTarget(rule miniov1beta1.NotificationRule) string {
+	switch {
+	case rule.WebhookConfiguration != nil:
+		return "webhook:" + rule.WebhookConfiguration.Endpoint
+	case rule.QueueArn != "":
+		return "queue:" + rule.QueueArn
+	default:
+		return "topic:" + rule.TopicArn
+	}
+}
+
+// isConfigUpToDate returns true if the events and filter of the config match the rule.
+// Wildcard events are expanded and filter rules are compared regardless of their order,
+// as the server may return them differently than they were written.
+func isConfigUpToDate(rule miniov1beta1.NotificationRule, config *notification.Config) bool {
+	observedEvents := make([]string, 0, len(config.Events))
+	for _, event := range config.Events {
+		observedEvents = append(observedEvents, string(event))
+	}
+	if !maps.Equal(expandEvents(rule.Events), expandEvents(observedEvents)) {
+		return false
+	}
+
+	return maps.Equal(specFilterRules(rule.Filter), observedFilterRules(config.Filter))
+}
+
+// expandEvents returns the set of concrete events matched by the given events.
+// Unknown events and wildcards without known concrete events are kept as they are.
+func expandEvents(events []string) map[string]bool {
+	expanded := map[string]bool{}
+	for _, event := range events {
+		event = strings.TrimSpace(event)
+		for _, concrete := range concreteEvents(event) {
+			expanded[concrete] = true
+		}
+	}
+	return expanded
+}
+
+// concreteEvents returns the known concrete events matched by a wildcard event.
+func concreteEvents(event string) []string {
+	prefix, isWildcard := strings.CutSuffix(event, "*")
+	if !isWildcard || !validS3Events[event] {
+		return []string{event}
+	}
+
+	concrete := []string{}
+	for candidate := range validS3Events {
+		if strings.HasPrefix(candidate, prefix) && !strings.HasSuffix(candidate, "*") {
+			concrete = append(concrete, candidate)
+		}
+	}
+	if len(concrete) == 0 {
+		return []string{event}
+	}
+	return concrete
+}
+
+// specFilterRules returns the key filter rules of the spec by name.
+func specFilterRules(filter *miniov1beta1.NotificationFilter) map[string]string {
+	rules := map[string]string{}
+	if filter == nil || filter.Key == nil {
+		return rules
+	}
+	for _, rule := range filter.Key.FilterRules {
+		rules[strings.ToLower(rule.Name)] = rule.Value
+	}
+	return rules
+}
+
+// observedFilterRules returns the key filter rules of the bucket notification entry by name.
+func observedFilterRules(filter *notification.Filter) map[string]string {
+	rules := map[string]string{}
+	if filter == nil {
+		return rules
+	}
+	for _, rule := range filter.S3Key.FilterRules {
+		rules[strings.ToLower(rule.Name)] = rule.Value
+	}
+	return rules
+}
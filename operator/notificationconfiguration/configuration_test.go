@@ -0,0 +1,380 @@
+package notificationconfiguration
+
+import (
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/notification"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const kafkaArn = "arn:minio:sqs::primary:kafka"
+
+func newNotificationConfiguration(name string, params miniov1beta1.NotificationConfigurationParameters) *miniov1beta1.NotificationConfiguration {
+	return &miniov1beta1.NotificationConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec:       miniov1beta1.NotificationConfigurationSpec{ForProvider: params},
+	}
+}
+
+func rulesParams() miniov1beta1.NotificationConfigurationParameters {
+	return miniov1beta1.NotificationConfigurationParameters{
+		BucketName: "bucket",
+		Rules: []miniov1beta1.NotificationRule{
+			{
+				ID:       "images",
+				QueueArn: kafkaArn,
+				Events:   []string{"s3:ObjectCreated:Put"},
+				Filter: &miniov1beta1.NotificationFilter{Key: &miniov1beta1.KeyFilter{
+					FilterRules: []miniov1beta1.FilterRule{{Name: "prefix", Value: "images/"}},
+				}},
+			},
+			{
+				ID:                   "deletes",
+				WebhookConfiguration: &miniov1beta1.WebhookConfiguration{ID: "service-b", Endpoint: "https://b.example.com"},
+				Events:               []string{"s3:ObjectRemoved:*"},
+			},
+		},
+	}
+}
+
+func TestAddRules(t *testing.T) {
+	cr := newNotificationConfiguration("routing", rulesParams())
+	config := notification.Configuration{}
+
+	addRules(cr, &config)
+
+	assert.Len(t, config.QueueConfigs, 1)
+	queue := config.QueueConfigs[0]
+	assert.Equal(t, "crossplane:default/routing:images", queue.ID)
+	assert.Equal(t, kafkaArn, queue.Queue)
+	assert.Equal(t, []notification.EventType{"s3:ObjectCreated:Put"}, queue.Events)
+	assert.Equal(t, []notification.FilterRule{{Name: "prefix", Value: "images/"}}, queue.Filter.S3Key.FilterRules)
+
+	assert.Len(t, config.LambdaConfigs, 1)
+	lambda := config.LambdaConfigs[0]
+	assert.Equal(t, "crossplane:default/routing:deletes", lambda.ID)
+	assert.Equal(t, "https://b.example.com", lambda.Lambda)
+	assert.Equal(t, "arn:minio:sqs::service-b:webhook", lambda.Arn.String())
+	assert.Nil(t, lambda.Filter)
+}
+
+func TestAddRules_TopLevelConfigurations(t *testing.T) {
+	cr := newNotificationConfiguration("legacy", miniov1beta1.NotificationConfigurationParameters{
+		QueueConfiguration: &miniov1beta1.QueueConfiguration{ID: "to-kafka", QueueArn: kafkaArn},
+		TopicConfiguration: &miniov1beta1.TopicConfiguration{ID: "to-sns", TopicArn: "arn:aws:sns:us-east-1:123456789012:events"},
+		Events:             []string{"s3:ObjectCreated:*"},
+	})
+	config := notification.Configuration{}
+
+	addRules(cr, &config)
+
+	assert.Len(t, config.QueueConfigs, 1)
+	assert.Equal(t, "crossplane:default/legacy:to-kafka", config.QueueConfigs[0].ID)
+	assert.Len(t, config.TopicConfigs, 1)
+	assert.Equal(t, "crossplane:default/legacy:to-sns", config.TopicConfigs[0].ID)
+	assert.Equal(t, []notification.EventType{"s3:ObjectCreated:*"}, config.TopicConfigs[0].Events)
+}
+
+func TestRemoveOwned(t *testing.T) {
+	cr := newNotificationConfiguration("routing", rulesParams())
+	other := newNotificationConfiguration("other", rulesParams())
+
+	config := notification.Configuration{}
+	addRules(cr, &config)
+	addRules(other, &config)
+	unmanaged := notification.QueueConfig{Config: notification.Config{ID: "manual"}, Queue: kafkaArn}
+	config.QueueConfigs = append(config.QueueConfigs, unmanaged)
+
+	removeOwned(cr, &config)
+
+	assert.Len(t, config.QueueConfigs, 2)
+	assert.Equal(t, "crossplane:default/other:images", config.QueueConfigs[0].ID)
+	assert.Equal(t, unmanaged, config.QueueConfigs[1])
+	assert.Len(t, config.LambdaConfigs, 1)
+	assert.Equal(t, "crossplane:default/other:deletes", config.LambdaConfigs[0].ID)
+}
+
+func TestRemoveOwned_EntriesWithoutMarker(t *testing.T) {
+	cr := newNotificationConfiguration("legacy", miniov1beta1.NotificationConfigurationParameters{
+		WebhookConfiguration: &miniov1beta1.WebhookConfiguration{ID: "hook", Endpoint: "https://a.example.com"},
+		QueueConfiguration:   &miniov1beta1.QueueConfiguration{ID: "to-kafka", QueueArn: kafkaArn},
+		Events:               []string{"s3:ObjectCreated:*"},
+	})
+	hookArn := notification.NewArn("minio", "sqs", "", "hook", "webhook")
+	legacyWebhook := notification.LambdaConfig{Config: notification.Config{Arn: hookArn}, Lambda: "https://a.example.com"}
+	otherEndpoint := notification.LambdaConfig{Config: notification.Config{Arn: hookArn}, Lambda: "https://other.example.com"}
+	otherArn := notification.LambdaConfig{
+		Config: notification.Config{Arn: notification.NewArn("minio", "sqs", "", "other", "webhook")},
+		Lambda: "https://a.example.com",
+	}
+	unmarkedQueue := notification.QueueConfig{Config: notification.Config{ID: "to-kafka"}, Queue: kafkaArn}
+	config := notification.Configuration{
+		LambdaConfigs: []notification.LambdaConfig{legacyWebhook, otherEndpoint, otherArn},
+		QueueConfigs:  []notification.QueueConfig{unmarkedQueue},
+	}
+
+	removeOwned(cr, &config)
+
+	assert.Equal(t, []notification.LambdaConfig{otherEndpoint, otherArn}, config.LambdaConfigs,
+		"only the webhook matching both ARN and endpoint is owned")
+	assert.Equal(t, []notification.QueueConfig{unmarkedQueue}, config.QueueConfigs,
+		"entries without marker are not owned because of a matching ID")
+}
+
+func TestObserveRules(t *testing.T) {
+	cr := newNotificationConfiguration("routing", rulesParams())
+	current := notification.Configuration{}
+	addRules(cr, &current)
+
+	tests := map[string]struct {
+		config           func() notification.Configuration
+		expectedExists   bool
+		expectedUpToDate bool
+	}{
+		"NoEntries": {
+			config: func() notification.Configuration { return notification.Configuration{} },
+		},
+		"OnlyOtherResources": {
+			config: func() notification.Configuration {
+				config := notification.Configuration{}
+				addRules(newNotificationConfiguration("other", rulesParams()), &config)
+				return config
+			},
+		},
+		"UpToDate": {
+			config: func() notification.Configuration {
+				config := notification.Configuration{}
+				addRules(newNotificationConfiguration("other", rulesParams()), &config)
+				addRules(cr, &config)
+				return config
+			},
+			expectedExists:   true,
+			expectedUpToDate: true,
+		},
+		"RuleMissing": {
+			config: func() notification.Configuration {
+				return notification.Configuration{QueueConfigs: current.QueueConfigs}
+			},
+			expectedExists: true,
+		},
+		"ExtraOwnedEntry": {
+			config: func() notification.Configuration {
+				config := notification.Configuration{LambdaConfigs: current.LambdaConfigs, QueueConfigs: current.QueueConfigs}
+				config.TopicConfigs = []notification.TopicConfig{{Config: notification.Config{ID: "crossplane:default/routing:removed"}}}
+				return config
+			},
+			expectedExists: true,
+		},
+		"TargetChanged": {
+			config: func() notification.Configuration {
+				queue := current.QueueConfigs[0]
+				queue.Queue = "arn:minio:sqs::primary:amqp"
+				return notification.Configuration{LambdaConfigs: current.LambdaConfigs, QueueConfigs: []notification.QueueConfig{queue}}
+			},
+			expectedExists: true,
+		},
+		"EventsChanged": {
+			config: func() notification.Configuration {
+				queue := current.QueueConfigs[0]
+				queue.Events = []notification.EventType{"s3:ObjectRemoved:*"}
+				return notification.Configuration{LambdaConfigs: current.LambdaConfigs, QueueConfigs: []notification.QueueConfig{queue}}
+			},
+			expectedExists: true,
+		},
+		"FilterMissing": {
+			config: func() notification.Configuration {
+				queue := current.QueueConfigs[0]
+				queue.Filter = nil
+				return notification.Configuration{LambdaConfigs: current.LambdaConfigs, QueueConfigs: []notification.QueueConfig{queue}}
+			},
+			expectedExists: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := tc.config()
+			exists, upToDate := observeRules(cr, &config)
+			assert.Equal(t, tc.expectedExists, exists)
+			assert.Equal(t, tc.expectedUpToDate, upToDate)
+		})
+	}
+}
+
+func TestValidateTargets(t *testing.T) {
+	tests := map[string]struct {
+		params  miniov1beta1.NotificationConfigurationParameters
+		wantErr string
+	}{
+		"Rules": {
+			params: rulesParams(),
+		},
+		"NoTarget": {
+			params:  miniov1beta1.NotificationConfigurationParameters{BucketName: "bucket"},
+			wantErr: "One of rules",
+		},
+		"TopLevelTargetWithoutEvents": {
+			params: miniov1beta1.NotificationConfigurationParameters{
+				QueueConfiguration: &miniov1beta1.QueueConfiguration{ID: "q", QueueArn: kafkaArn},
+			},
+			wantErr: "At least one event is required",
+		},
+		"RuleWithTwoTargets": {
+			params: miniov1beta1.NotificationConfigurationParameters{Rules: []miniov1beta1.NotificationRule{
+				{ID: "r", QueueArn: kafkaArn, WebhookConfiguration: &miniov1beta1.WebhookConfiguration{ID: "r", Endpoint: "https://example.com"}, Events: []string{"s3:ObjectCreated:*"}},
+			}},
+			wantErr: "Exactly one of",
+		},
+		"RuleWithTopic": {
+			params: miniov1beta1.NotificationConfigurationParameters{Rules: []miniov1beta1.NotificationRule{
+				{ID: "r", TopicArn: "arn:aws:sns:us-east-1:123456789012:events", Events: []string{"s3:ObjectCreated:*"}},
+			}},
+			wantErr: "does not support topic configurations",
+		},
+		"TopLevelTopic": {
+			params: miniov1beta1.NotificationConfigurationParameters{
+				TopicConfiguration: &miniov1beta1.TopicConfiguration{ID: "t", TopicArn: "arn:aws:sns:us-east-1:123456789012:events"},
+				Events:             []string{"s3:ObjectCreated:*"},
+			},
+			wantErr: "does not support topic configurations",
+		},
+		"RuleWithoutEvents": {
+			params: miniov1beta1.NotificationConfigurationParameters{Rules: []miniov1beta1.NotificationRule{
+				{ID: "r", QueueArn: kafkaArn},
+			}},
+			wantErr: "at least one event is required",
+		},
+		"RuleWithTwoPrefixes": {
+			params: miniov1beta1.NotificationConfigurationParameters{Rules: []miniov1beta1.NotificationRule{
+				{ID: "r", QueueArn: kafkaArn, Events: []string{"s3:ObjectCreated:*"}, Filter: &miniov1beta1.NotificationFilter{Key: &miniov1beta1.KeyFilter{
+					FilterRules: []miniov1beta1.FilterRule{{Name: "prefix", Value: "a/"}, {Name: "prefix", Value: "b/"}},
+				}}},
+			}},
+			wantErr: "duplicate filter rule name",
+		},
+		"TopLevelInvalidFilter": {
+			params: miniov1beta1.NotificationConfigurationParameters{
+				QueueConfiguration: &miniov1beta1.QueueConfiguration{ID: "q", QueueArn: kafkaArn},
+				Events:             []string{"s3:ObjectCreated:*"},
+				Filter: &miniov1beta1.NotificationFilter{Key: &miniov1beta1.KeyFilter{
+					FilterRules: []miniov1beta1.FilterRule{{Name: "suffix"}},
+				}},
+			},
+			wantErr: "cannot be empty",
+		},
+		"DuplicateIDs": {
+			params: miniov1beta1.NotificationConfigurationParameters{
+				QueueConfiguration: &miniov1beta1.QueueConfiguration{ID: "r", QueueArn: kafkaArn},
+				Events:             []string{"s3:ObjectCreated:*"},
+				Rules: []miniov1beta1.NotificationRule{
+					{ID: "r", QueueArn: kafkaArn, Events: []string{"s3:ObjectCreated:*"}},
+				},
+			},
+			wantErr: "Duplicate value",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateTargets(tc.params)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestDeprecationWarnings(t *testing.T) {
+	token := "token"
+	params := rulesParams()
+	assert.Empty(t, deprecationWarnings(params))
+
+	params.Rules[1].WebhookConfiguration.AuthToken = &token
+	params.Rules[1].WebhookConfiguration.UserAgent = &token
+	warnings := deprecationWarnings(params)
+	assert.Len(t, warnings, 2)
+	assert.Contains(t, warnings[0], "NotificationTarget")
+}
+
+func TestIsConfigUpToDate(t *testing.T) {
+	rule := miniov1beta1.NotificationRule{
+		ID:     "images",
+		Events: []string{"s3:ObjectCreated:*", "s3:ObjectRemoved:Delete"},
+		Filter: &miniov1beta1.NotificationFilter{Key: &miniov1beta1.KeyFilter{FilterRules: []miniov1beta1.FilterRule{
+			{Name: "suffix", Value: ".jpg"},
+			{Name: "prefix", Value: "images/"},
+		}}},
+	}
+	filter := &notification.Filter{S3Key: notification.S3Key{FilterRules: []notification.FilterRule{
+		{Name: "prefix", Value: "images/"},
+		{Name: "suffix", Value: ".jpg"},
+	}}}
+
+	tests := map[string]struct {
+		config   notification.Config
+		expected bool
+	}{
+		"SameEventsReorderedFilter": {
+			config:   notification.Config{Events: []notification.EventType{"s3:ObjectRemoved:Delete", "s3:ObjectCreated:*"}, Filter: filter},
+			expected: true,
+		},
+		"ExpandedWildcard": {
+			config: notification.Config{Events: []notification.EventType{
+				"s3:ObjectCreated:Put", "s3:ObjectCreated:Post", "s3:ObjectCreated:Copy",
+				"s3:ObjectCreated:CompleteMultipartUpload", "s3:ObjectCreated:PutRetention", "s3:ObjectCreated:PutLegalHold",
+				"s3:ObjectCreated:PutTagging", "s3:ObjectCreated:DeleteTagging", "s3:ObjectRemoved:Delete",
+			}, Filter: filter},
+			expected: true,
+		},
+		"PartialWildcard": {
+			config: notification.Config{Events: []notification.EventType{"s3:ObjectCreated:Put", "s3:ObjectRemoved:Delete"}, Filter: filter},
+		},
+		"CapitalizedFilterNames": {
+			config: notification.Config{Events: []notification.EventType{"s3:ObjectCreated:*", "s3:ObjectRemoved:Delete"}, Filter: &notification.Filter{S3Key: notification.S3Key{FilterRules: []notification.FilterRule{
+				{Name: "Suffix", Value: ".jpg"},
+				{Name: "Prefix", Value: "images/"},
+			}}}},
+			expected: true,
+		},
+		"FilterValueChanged": {
+			config: notification.Config{Events: []notification.EventType{"s3:ObjectCreated:*", "s3:ObjectRemoved:Delete"}, Filter: &notification.Filter{S3Key: notification.S3Key{FilterRules: []notification.FilterRule{
+				{Name: "prefix", Value: "images/"},
+				{Name: "suffix", Value: ".png"},
+			}}}},
+		},
+		"FilterMissing": {
+			config: notification.Config{Events: []notification.EventType{"s3:ObjectCreated:*", "s3:ObjectRemoved:Delete"}},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, isConfigUpToDate(rule, &tc.config))
+		})
+	}
+}
+
+func TestIsConfigUpToDate_EmptyKeyFilter(t *testing.T) {
+	rule := miniov1beta1.NotificationRule{
+		Events: []string{"s3:ObjectCreated:Put"},
+		Filter: &miniov1beta1.NotificationFilter{Key: &miniov1beta1.KeyFilter{}},
+	}
+	assert.True(t, isConfigUpToDate(rule, &notification.Config{Events: []notification.EventType{"s3:ObjectCreated:Put"}}))
+}
+
+func TestExpandEvents(t *testing.T) {
+	assert.Equal(t, map[string]bool{
+		"s3:ObjectAccessed:Get":          true,
+		"s3:ObjectAccessed:Head":         true,
+		"s3:ObjectAccessed:GetRetention": true,
+		"s3:ObjectAccessed:GetLegalHold": true,
+		"s3:ObjectAccessed:Attributes":   true,
+	}, expandEvents([]string{"s3:ObjectAccessed:*"}))
+	assert.Equal(t, map[string]bool{"s3:Scanner:ManyVersions": true, "s3:Scanner:BigPrefix": true}, expandEvents([]string{"s3:Scanner:*"}))
+	assert.Equal(t, map[string]bool{"s3:BucketCreated:*": true}, expandEvents([]string{"s3:BucketCreated:*"}))
+	assert.Equal(t, map[string]bool{"s3:Custom:*": true}, expandEvents([]string{"s3:Custom:*"}))
+}
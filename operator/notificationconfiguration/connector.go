@@ -53,12 +53,12 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, err
 	}
 
-	ma, err := minioutil.NewMinioAdmin(ctx, c.kube, config)
+	ma, err := minioutil.NewMinioAdmin(ctx, c.kube, config, miniov1beta1.NotificationConfigurationKind)
 	if err != nil {
 		return nil, err
 	}
 
-	mc, err := minioutil.NewMinioClient(ctx, c.kube, config)
+	mc, err := minioutil.NewMinioClient(ctx, c.kube, config, miniov1beta1.NotificationConfigurationKind)
 	if err != nil {
 		return nil, err
 	}
@@ -2,13 +2,11 @@ package notificationconfiguration
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
-	"github.com/minio/minio-go/v7/pkg/notification"
 	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
@@ -24,11 +22,6 @@ func (nc *notificationClient) Delete(ctx context.Context, mg resource.Managed) (
 
 	cr.SetConditions(xpv1.Deleting())
 
-	webhookConfig := cr.Spec.ForProvider.WebhookConfiguration
-	if webhookConfig == nil {
-		return managed.ExternalDelete{}, nil
-	}
-
 	// Get current bucket notification configuration
 	config, err := nc.mc.GetBucketNotification(ctx, cr.Spec.ForProvider.BucketName)
 	if err != nil {
@@ -39,17 +32,8 @@ func (nc *notificationClient) Delete(ctx context.Context, mg resource.Managed) (
 		return managed.ExternalDelete{}, err
 	}
 
-	// Remove our webhook configuration from the bucket
-	webhookID := webhookConfig.ID
-	expectedARN := fmt.Sprintf("arn:minio:sqs::%s:webhook", webhookID)
-
-	filtered := []notification.LambdaConfig{}
-	for _, lambda := range config.LambdaConfigs {
-		if lambda.Arn.String() != expectedARN || lambda.Lambda != webhookConfig.Endpoint {
-			filtered = append(filtered, lambda)
-		}
-	}
-	config.LambdaConfigs = filtered
+	// Remove the entries owned by this resource from the bucket
+	removeOwned(cr, &config)
 
 	// Update bucket notification
 	err = nc.mc.SetBucketNotification(ctx, cr.Spec.ForProvider.BucketName, config)
@@ -9,7 +9,7 @@ func (nc *notificationClient) emitCreationEvent(cr *miniov1beta1.NotificationCon
 	nc.recorder.Event(cr, event.Event{
 		Type:    event.TypeNormal,
 		Reason:  "Created",
-		Message: "Webhook notification configuration successfully created",
+		Message: "Notification configuration successfully created",
 	})
 }
 
@@ -17,7 +17,7 @@ func (nc *notificationClient) emitDeletionEvent(cr *miniov1beta1.NotificationCon
 	nc.recorder.Event(cr, event.Event{
 		Type:    event.TypeNormal,
 		Reason:  "Deleted",
-		Message: "Webhook notification configuration successfully deleted",
+		Message: "Notification configuration successfully deleted",
 	})
 }
 
@@ -25,6 +25,6 @@ func (nc *notificationClient) emitUpdateEvent(cr *miniov1beta1.NotificationConfi
 	nc.recorder.Event(cr, event.Event{
 		Type:    event.TypeNormal,
 		Reason:  "Updated",
-		Message: "Webhook notification configuration successfully updated",
+		Message: "Notification configuration successfully updated",
 	})
 }
@@ -0,0 +1,82 @@
+package notificationconfiguration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7/pkg/notification"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/rossigee/provider-minio/operator/minioutil"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// requiredTargets returns the ARNs of the notification targets on the MinIO server that are referenced by the rules.
+// Topic ARNs and queue ARNs of other partitions refer to targets outside of MinIO and are not included.
+func requiredTargets(params miniov1beta1.NotificationConfigurationParameters) []notification.Arn {
+	arns := []notification.Arn{}
+	for _, rule := range desiredRules(params) {
+		switch {
+		case rule.WebhookConfiguration != nil:
+			arns = append(arns, webhookARN(rule.WebhookConfiguration))
+		case rule.QueueArn != "":
+			arn, err := notification.NewArnFromString(rule.QueueArn)
+			if err == nil && arn.Partition == "minio" {
+				arns = append(arns, arn)
+			}
+		}
+	}
+	return arns
+}
+
+// missingTargets returns the required ARNs that are not advertised by the server.
+func missingTargets(params miniov1beta1.NotificationConfigurationParameters, advertised []string) []string {
+	missing := []string{}
+	for _, required := range requiredTargets(params) {
+		if !minioutil.HasTargetARN(advertised, required) {
+			missing = append(missing, required.String())
+		}
+	}
+	return missing
+}
+
+// serverTargets returns the notification target ARNs advertised by the server.
+func serverTargets(ctx context.Context, ma *madmin.AdminClient) ([]string, error) {
+	info, err := ma.ServerInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return info.SQSARN, nil
+}
+
+// checkTargets sets the TargetsConfigured condition and returns an error if a target referenced
+// by the rules is not configured on the server. The check is skipped if the server does not
+// advertise its targets, e.g. because the credentials lack admin permissions.
+func (nc *notificationClient) checkTargets(ctx context.Context, cr *miniov1beta1.NotificationConfiguration) error {
+	if len(requiredTargets(cr.Spec.ForProvider)) == 0 {
+		return nil
+	}
+
+	advertised, err := serverTargets(ctx, nc.ma)
+	if err != nil {
+		ctrl.LoggerFrom(ctx).Info("cannot get notification targets of the server, skipping check", "error", err.Error())
+		return nil
+	}
+
+	missing := missingTargets(cr.Spec.ForProvider, advertised)
+	if len(missing) > 0 {
+		condition := miniov1beta1.TargetNotConfigured(missing)
+		cr.SetConditions(condition)
+		nc.recorder.Event(cr, event.Event{
+			Type:    event.TypeWarning,
+			Reason:  event.Reason(condition.Reason),
+			Message: condition.Message,
+		})
+		return fmt.Errorf("notification targets are not configured on the server: %s", strings.Join(missing, ", "))
+	}
+
+	cr.SetConditions(miniov1beta1.TargetsConfigured())
+	return nil
+}
@@ -0,0 +1,130 @@
+package notificationconfiguration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type eventRecorder struct {
+	events []event.Event
+}
+
+func (r *eventRecorder) Event(_ runtime.Object, e event.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *eventRecorder) WithAnnotations(_ ...string) event.Recorder {
+	return r
+}
+
+func TestMissingTargets(t *testing.T) {
+	tests := map[string]struct {
+		advertised []string
+		expected   []string
+	}{
+		"AllConfigured": {
+			advertised: []string{"arn:minio:sqs::primary:kafka", "arn:minio:sqs::service-b:webhook"},
+			expected:   []string{},
+		},
+		"RegionOnServer": {
+			advertised: []string{"arn:minio:sqs:us-east-1:primary:kafka", "arn:minio:sqs:us-east-1:service-b:webhook"},
+			expected:   []string{},
+		},
+		"WebhookMissing": {
+			advertised: []string{"arn:minio:sqs::primary:kafka", "arn:minio:sqs::service-b:kafka"},
+			expected:   []string{"arn:minio:sqs::service-b:webhook"},
+		},
+		"NoTargets": {
+			expected: []string{kafkaArn, "arn:minio:sqs::service-b:webhook"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, missingTargets(rulesParams(), tc.advertised))
+		})
+	}
+}
+
+func TestRequiredTargets_IgnoresExternalTargets(t *testing.T) {
+	params := miniov1beta1.NotificationConfigurationParameters{Rules: []miniov1beta1.NotificationRule{
+		{ID: "sns", TopicArn: "arn:aws:sns:us-east-1:123456789012:events"},
+		{ID: "sqs", QueueArn: "arn:aws:sqs:us-east-1:123456789012:events"},
+	}}
+	assert.Empty(t, requiredTargets(params))
+}
+
+func TestCheckTargets(t *testing.T) {
+	tests := map[string]struct {
+		status         int
+		advertised     []string
+		wantErr        bool
+		expectedReason string
+		expectEvent    bool
+	}{
+		"Configured": {
+			status:         http.StatusOK,
+			advertised:     []string{kafkaArn, "arn:minio:sqs::service-b:webhook"},
+			expectedReason: "TargetsConfigured",
+		},
+		"NotConfigured": {
+			status:         http.StatusOK,
+			advertised:     []string{kafkaArn},
+			wantErr:        true,
+			expectedReason: "TargetNotConfigured",
+			expectEvent:    true,
+		},
+		"ServerInfoForbidden": {
+			status: http.StatusForbidden,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/minio/admin/v3/info" || tc.status != http.StatusOK {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+				_ = json.NewEncoder(w).Encode(madmin.InfoMessage{SQSARN: tc.advertised})
+			}))
+			t.Cleanup(srv.Close)
+
+			parsed, err := url.Parse(srv.URL)
+			require.NoError(t, err)
+			ma, err := madmin.New(parsed.Host, "admin", "password", false)
+			require.NoError(t, err)
+
+			recorder := &eventRecorder{}
+			client := &notificationClient{ma: ma, recorder: recorder}
+			cr := newNotificationConfiguration("routing", rulesParams())
+
+			err = client.checkTargets(context.Background(), cr)
+			if tc.wantErr {
+				assert.ErrorContains(t, err, "arn:minio:sqs::service-b:webhook")
+			} else {
+				assert.NoError(t, err)
+			}
+
+			condition := cr.GetCondition(miniov1beta1.TypeTargetsConfigured)
+			if tc.expectedReason == "" {
+				assert.Equal(t, corev1.ConditionUnknown, condition.Status)
+			} else {
+				assert.Equal(t, tc.expectedReason, string(condition.Reason))
+			}
+			assert.Equal(t, tc.expectEvent, len(recorder.events) == 1)
+		})
+	}
+}
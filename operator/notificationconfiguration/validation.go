@@ -3,6 +3,9 @@ package notificationconfiguration
 import (
 	"fmt"
 	"strings"
+
+	"github.com/minio/minio-go/v7/pkg/notification"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
 )
 
 // Valid S3 event types for bucket notifications
@@ -13,11 +16,31 @@ var validS3Events = map[string]bool{
 	"s3:ObjectCreated:Post":                    true,
 	"s3:ObjectCreated:Copy":                    true,
 	"s3:ObjectCreated:CompleteMultipartUpload": true,
+	"s3:ObjectCreated:PutRetention":            true,
+	"s3:ObjectCreated:PutLegalHold":            true,
+	"s3:ObjectCreated:PutTagging":              true,
+	"s3:ObjectCreated:DeleteTagging":           true,
+
+	// Object access events
+	"s3:ObjectAccessed:*":            true,
+	"s3:ObjectAccessed:Get":          true,
+	"s3:ObjectAccessed:Head":         true,
+	"s3:ObjectAccessed:GetRetention": true,
+	"s3:ObjectAccessed:GetLegalHold": true,
+	"s3:ObjectAccessed:Attributes":   true,
 
 	// Object removal events
 	"s3:ObjectRemoved:*":                   true,
 	"s3:ObjectRemoved:Delete":              true,
 	"s3:ObjectRemoved:DeleteMarkerCreated": true,
+	"s3:ObjectRemoved:DeleteAllVersions":   true,
+	"s3:ObjectRemoved:NoOP":                true,
+
+	// ILM events
+	"s3:LifecycleDelMarkerExpiration:Delete": true,
+	"s3:ObjectTransition:*":                  true,
+	"s3:ObjectTransition:Failed":             true,
+	"s3:ObjectTransition:Complete":           true,
 
 	// Object restoration events
 	"s3:ObjectRestore:*":         true,
@@ -34,11 +57,22 @@ var validS3Events = map[string]bool{
 	"s3:ObjectAcl:Put": true,
 
 	// Replication events
-	"s3:Replication:*":                                 true,
+	"s3:Replication:*": true,
+	"s3:Replication:OperationCompletedReplication":     true,
 	"s3:Replication:OperationFailedReplication":        true,
 	"s3:Replication:OperationNotTracked":               true,
 	"s3:Replication:OperationMissedThreshold":          true,
 	"s3:Replication:OperationReplicatedAfterThreshold": true,
+
+	// Scanner events
+	"s3:Scanner:*":            true,
+	"s3:Scanner:ManyVersions": true,
+	"s3:Scanner:BigPrefix":    true,
+
+	// Other events
+	"s3:ReducedRedundancyLostObject": true,
+	"s3:BucketCreated:*":             true,
+	"s3:BucketRemoved:*":             true,
 }
 
 // ValidateS3Event checks if the given event is a valid S3 notification event
@@ -87,3 +121,72 @@ func ValidateFilterRules(rules []string) error {
 
 	return nil
 }
+
+// maxFilterValueLength is the maximum length of a filter rule value accepted by S3
+const maxFilterValueLength = 1024
+
+// ValidateFilter checks the key filter rules of a notification filter.
+// A filter has at most one prefix and one suffix rule.
+func ValidateFilter(filter *miniov1beta1.NotificationFilter) error {
+	if filter == nil || filter.Key == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(filter.Key.FilterRules))
+	for _, rule := range filter.Key.FilterRules {
+		names = append(names, rule.Name)
+	}
+	if err := ValidateFilterRules(names); err != nil {
+		return err
+	}
+
+	seen := map[string]bool{}
+	for _, rule := range filter.Key.FilterRules {
+		if seen[rule.Name] {
+			return fmt.Errorf("duplicate filter rule name: %s (at most one prefix and one suffix are allowed)", rule.Name)
+		}
+		seen[rule.Name] = true
+
+		if rule.Value == "" {
+			return fmt.Errorf("value of filter rule %s cannot be empty", rule.Name)
+		}
+		if len(rule.Value) > maxFilterValueLength {
+			return fmt.Errorf("value of filter rule %s must not be longer than %d characters", rule.Name, maxFilterValueLength)
+		}
+	}
+
+	return nil
+}
+
+// Notification target types supported by MinIO, as used in the resource part of a queue ARN
+var validMinioTargetTypes = map[string]bool{
+	"amqp":          true,
+	"elasticsearch": true,
+	"kafka":         true,
+	"mqtt":          true,
+	"mysql":         true,
+	"nats":          true,
+	"nsq":           true,
+	"postgresql":    true,
+	"redis":         true,
+	"webhook":       true,
+}
+
+// ValidateQueueArn checks that the ARN identifies a queue, e.g. arn:minio:sqs::primary:kafka
+func ValidateQueueArn(arn string) error {
+	return validateTargetArn(arn, "sqs")
+}
+
+func validateTargetArn(arn, service string) error {
+	parsed, err := notification.NewArnFromString(arn)
+	if err != nil {
+		return fmt.Errorf("invalid ARN %s: %w", arn, err)
+	}
+	if parsed.Service != service {
+		return fmt.Errorf("invalid ARN %s: service must be '%s'", arn, service)
+	}
+	if parsed.Partition == "minio" && !validMinioTargetTypes[parsed.Resource] {
+		return fmt.Errorf("invalid ARN %s: unknown MinIO target type '%s'", arn, parsed.Resource)
+	}
+	return nil
+}
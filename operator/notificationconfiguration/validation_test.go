@@ -1,7 +1,10 @@
 package notificationconfiguration
 
 import (
+	"strings"
 	"testing"
+
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
 )
 
 func TestValidateS3Event(t *testing.T) {
@@ -25,6 +28,26 @@ func TestValidateS3Event(t *testing.T) {
 			event:   "s3:ObjectCreated:Put",
 			wantErr: false,
 		},
+		{
+			name:    "valid object accessed get event",
+			event:   "s3:ObjectAccessed:Get",
+			wantErr: false,
+		},
+		{
+			name:    "valid object removed noop event",
+			event:   "s3:ObjectRemoved:NoOP",
+			wantErr: false,
+		},
+		{
+			name:    "valid object transition all event",
+			event:   "s3:ObjectTransition:*",
+			wantErr: false,
+		},
+		{
+			name:    "valid scanner all event",
+			event:   "s3:Scanner:*",
+			wantErr: false,
+		},
 		{
 			name:    "invalid event",
 			event:   "s3:InvalidEvent:*",
@@ -132,3 +155,62 @@ func TestValidateFilterRules(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateQueueArn(t *testing.T) {
+	tests := map[string]struct {
+		arn     string
+		wantErr bool
+	}{
+		"kafka":          {arn: "arn:minio:sqs::primary:kafka"},
+		"amqp":           {arn: "arn:minio:sqs:us-east-1:1:amqp"},
+		"postgresql":     {arn: "arn:minio:sqs::db:postgresql"},
+		"elasticsearch":  {arn: "arn:minio:sqs::search:elasticsearch"},
+		"aws queue":      {arn: "arn:aws:sqs:us-east-1:123456789012:queue"},
+		"unknown type":   {arn: "arn:minio:sqs::primary:kinesis", wantErr: true},
+		"topic service":  {arn: "arn:minio:sns::primary:kafka", wantErr: true},
+		"missing prefix": {arn: "minio:sqs::primary:kafka:x", wantErr: true},
+		"too few parts":  {arn: "arn:minio:sqs:primary", wantErr: true},
+		"empty":          {arn: "", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateQueueArn(tt.arn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateQueueArn() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateFilter(t *testing.T) {
+	keyFilter := func(rules ...miniov1beta1.FilterRule) *miniov1beta1.NotificationFilter {
+		return &miniov1beta1.NotificationFilter{Key: &miniov1beta1.KeyFilter{FilterRules: rules}}
+	}
+
+	tests := map[string]struct {
+		filter  *miniov1beta1.NotificationFilter
+		wantErr bool
+	}{
+		"no filter":         {},
+		"no key filter":     {filter: &miniov1beta1.NotificationFilter{}},
+		"prefix":            {filter: keyFilter(miniov1beta1.FilterRule{Name: "prefix", Value: "images/"})},
+		"suffix and prefix": {filter: keyFilter(miniov1beta1.FilterRule{Name: "suffix", Value: ".jpg"}, miniov1beta1.FilterRule{Name: "prefix", Value: "images/"})},
+		"invalid name":      {filter: keyFilter(miniov1beta1.FilterRule{Name: "contains", Value: "x"}), wantErr: true},
+		"two prefixes": {
+			filter:  keyFilter(miniov1beta1.FilterRule{Name: "prefix", Value: "a/"}, miniov1beta1.FilterRule{Name: "prefix", Value: "b/"}),
+			wantErr: true,
+		},
+		"empty value":    {filter: keyFilter(miniov1beta1.FilterRule{Name: "suffix"}), wantErr: true},
+		"value too long": {filter: keyFilter(miniov1beta1.FilterRule{Name: "prefix", Value: strings.Repeat("a", 1025)}), wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateFilter(tt.filter)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateFilter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
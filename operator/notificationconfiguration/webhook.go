@@ -2,14 +2,24 @@ package notificationconfiguration
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	providerv1 "github.com/rossigee/provider-minio/apis/provider/v1"
+	"github.com/rossigee/provider-minio/operator/minioutil"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
+// targetCheckTimeout limits the time spent querying the server during admission.
+const targetCheckTimeout = 5 * time.Second
+
+// topicsNotSupported is the reason topic configurations are rejected, MinIO refuses to store them.
+const topicsNotSupported = "MinIO does not support topic configurations, use a queue target instead"
+
 var (
 	_ admission.Validator[*miniov1beta1.NotificationConfiguration] = &Validator{}
 )
@@ -33,27 +43,11 @@ func (v *Validator) ValidateCreate(ctx context.Context, nc *miniov1beta1.Notific
 		return nil, field.Invalid(field.NewPath("spec", "forProvider", "bucketName"), "", "Bucket name is required")
 	}
 
-	if len(nc.Spec.ForProvider.Events) == 0 {
-		return nil, field.Invalid(field.NewPath("spec", "forProvider", "events"), nil, "At least one event is required")
-	}
-
-	if err := ValidateEvents(nc.Spec.ForProvider.Events); err != nil {
-		return nil, field.Invalid(field.NewPath("spec", "forProvider", "events"), nc.Spec.ForProvider.Events, err.Error())
-	}
-
-	if nc.Spec.ForProvider.WebhookConfiguration == nil {
-		return nil, field.Invalid(field.NewPath("spec", "forProvider", "webhookConfiguration"), nil, "Webhook configuration is required")
-	}
-
-	if nc.Spec.ForProvider.WebhookConfiguration.Endpoint == "" {
-		return nil, field.Invalid(field.NewPath("spec", "forProvider", "webhookConfiguration", "endpoint"), "", "Endpoint is required")
-	}
-
-	if nc.Spec.ForProvider.WebhookConfiguration.ID == "" {
-		return nil, field.Invalid(field.NewPath("spec", "forProvider", "webhookConfiguration", "id"), "", "ID is required")
+	if err := validateTargets(nc.Spec.ForProvider); err != nil {
+		return nil, err
 	}
 
-	return nil, nil
+	return append(deprecationWarnings(nc.Spec.ForProvider), v.targetWarnings(ctx, nc)...), nil
 }
 
 // ValidateUpdate implements admission.Validator.
@@ -73,11 +67,11 @@ func (v *Validator) ValidateUpdate(ctx context.Context, oldNC, newNC *miniov1bet
 		return nil, nil
 	}
 
-	if len(newNC.Spec.ForProvider.Events) == 0 {
-		return nil, field.Invalid(field.NewPath("spec", "forProvider", "events"), nil, "At least one event is required")
+	if err := validateTargets(newNC.Spec.ForProvider); err != nil {
+		return nil, err
 	}
 
-	return nil, nil
+	return append(deprecationWarnings(newNC.Spec.ForProvider), v.targetWarnings(ctx, newNC)...), nil
 }
 
 // ValidateDelete implements admission.Validator.
@@ -85,3 +79,155 @@ func (v *Validator) ValidateDelete(_ context.Context, _ *miniov1beta1.Notificati
 	v.log.V(1).Info("validate delete (noop)")
 	return nil, nil
 }
+
+func validateTargets(params miniov1beta1.NotificationConfigurationParameters) error {
+	path := field.NewPath("spec", "forProvider")
+	if !hasTarget(params) {
+		return field.Required(path, "One of rules, webhookConfiguration or queueConfiguration is required")
+	}
+
+	// The top-level targets share the top-level events and filter
+	if params.WebhookConfiguration != nil || params.QueueConfiguration != nil || params.TopicConfiguration != nil {
+		if len(params.Events) == 0 {
+			return field.Invalid(path.Child("events"), nil, "At least one event is required")
+		}
+		if err := ValidateEvents(params.Events); err != nil {
+			return field.Invalid(path.Child("events"), params.Events, err.Error())
+		}
+		if err := ValidateFilter(params.Filter); err != nil {
+			return field.Invalid(path.Child("filter"), params.Filter, err.Error())
+		}
+	}
+
+	if webhookConfig := params.WebhookConfiguration; webhookConfig != nil {
+		if err := validateWebhookConfiguration(path.Child("webhookConfiguration"), webhookConfig); err != nil {
+			return err
+		}
+	}
+
+	if queueConfig := params.QueueConfiguration; queueConfig != nil {
+		if queueConfig.ID == "" {
+			return field.Invalid(path.Child("queueConfiguration", "id"), "", "ID is required")
+		}
+		if err := ValidateQueueArn(queueConfig.QueueArn); err != nil {
+			return field.Invalid(path.Child("queueConfiguration", "queueArn"), queueConfig.QueueArn, err.Error())
+		}
+	}
+
+	if params.TopicConfiguration != nil {
+		return field.Forbidden(path.Child("topicConfiguration"), topicsNotSupported)
+	}
+
+	// IDs of top-level targets and rules share the same namespace
+	ids := map[string]bool{}
+	for _, rule := range desiredRules(params) {
+		if ids[rule.ID] {
+			return field.Duplicate(path.Child("rules"), rule.ID)
+		}
+		ids[rule.ID] = true
+	}
+
+	for i, rule := range params.Rules {
+		if err := validateRule(path.Child("rules").Index(i), rule); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// targetWarnings warns about referenced notification targets that are not configured on the server.
+// The targets may still be configured before the resource is reconciled, so they do not reject the request.
+// Errors connecting to the server are ignored, the controller performs the same check.
+func (v *Validator) targetWarnings(ctx context.Context, nc *miniov1beta1.NotificationConfiguration) admission.Warnings {
+	if v.kube == nil || len(requiredTargets(nc.Spec.ForProvider)) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, targetCheckTimeout)
+	defer cancel()
+
+	config := &providerv1.ProviderConfig{}
+	if err := v.kube.Get(ctx, client.ObjectKey{Name: nc.GetProviderConfigReference().Name}, config); err != nil {
+		v.log.V(1).Info("cannot get provider config, skipping target check", "error", err.Error())
+		return nil
+	}
+	ma, err := minioutil.NewMinioAdmin(ctx, v.kube, config, miniov1beta1.NotificationConfigurationKind)
+	if err != nil {
+		v.log.V(1).Info("cannot connect to server, skipping target check", "error", err.Error())
+		return nil
+	}
+	advertised, err := serverTargets(ctx, ma)
+	if err != nil {
+		v.log.V(1).Info("cannot get notification targets of the server, skipping target check", "error", err.Error())
+		return nil
+	}
+
+	var warnings admission.Warnings
+	for _, arn := range missingTargets(nc.Spec.ForProvider, advertised) {
+		warnings = append(warnings, fmt.Sprintf("notification target %s is not configured on the server", arn))
+	}
+	return warnings
+}
+
+// deprecationWarnings warns about webhook settings that cannot be applied by a bucket notification.
+func deprecationWarnings(params miniov1beta1.NotificationConfigurationParameters) admission.Warnings {
+	var warnings admission.Warnings
+	for _, rule := range desiredRules(params) {
+		webhookConfig := rule.WebhookConfiguration
+		if webhookConfig == nil {
+			continue
+		}
+		if webhookConfig.AuthToken != nil {
+			warnings = append(warnings, fmt.Sprintf("authToken of webhook %q has no effect, use a NotificationTarget with authTokenSecretRef instead", webhookConfig.ID))
+		}
+		if webhookConfig.UserAgent != nil {
+			warnings = append(warnings, fmt.Sprintf("userAgent of webhook %q has no effect", webhookConfig.ID))
+		}
+	}
+	return warnings
+}
+
+func validateRule(path *field.Path, rule miniov1beta1.NotificationRule) error {
+	if rule.ID == "" {
+		return field.Invalid(path.Child("id"), "", "ID is required")
+	}
+
+	targets := 0
+	if rule.WebhookConfiguration != nil {
+		targets++
+		if err := validateWebhookConfiguration(path.Child("webhookConfiguration"), rule.WebhookConfiguration); err != nil {
+			return err
+		}
+	}
+	if rule.QueueArn != "" {
+		targets++
+		if err := ValidateQueueArn(rule.QueueArn); err != nil {
+			return field.Invalid(path.Child("queueArn"), rule.QueueArn, err.Error())
+		}
+	}
+	if rule.TopicArn != "" {
+		return field.Forbidden(path.Child("topicArn"), topicsNotSupported)
+	}
+	if targets != 1 {
+		return field.Invalid(path, rule.ID, "Exactly one of webhookConfiguration or queueArn is required")
+	}
+
+	if err := ValidateEvents(rule.Events); err != nil {
+		return field.Invalid(path.Child("events"), rule.Events, err.Error())
+	}
+	if err := ValidateFilter(rule.Filter); err != nil {
+		return field.Invalid(path.Child("filter"), rule.Filter, err.Error())
+	}
+	return nil
+}
+
+func validateWebhookConfiguration(path *field.Path, webhookConfig *miniov1beta1.WebhookConfiguration) error {
+	if webhookConfig.Endpoint == "" {
+		return field.Invalid(path.Child("endpoint"), "", "Endpoint is required")
+	}
+	if webhookConfig.ID == "" {
+		return field.Invalid(path.Child("id"), "", "ID is required")
+	}
+	return nil
+}
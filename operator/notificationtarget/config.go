@@ -0,0 +1,131 @@
+package notificationtarget
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7/pkg/notification"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/rossigee/provider-minio/operator/minioutil"
+)
+
+const (
+	enableKey     = "enable"
+	authTokenKey  = "auth_token"
+	queueDirKey   = "queue_dir"
+	queueLimitKey = "queue_limit"
+)
+
+// endpointKeys maps the target types to the configuration parameter holding the address of the target.
+var endpointKeys = map[string]string{
+	"webhook":       "endpoint",
+	"kafka":         "brokers",
+	"amqp":          "url",
+	"nats":          "address",
+	"redis":         "address",
+	"postgresql":    "connection_string",
+	"mysql":         "dsn_string",
+	"elasticsearch": "url",
+	"mqtt":          "broker",
+	"nsq":           "nsqd_address",
+}
+
+// subSystem returns the configuration sub-system of the target type.
+func subSystem(targetType string) string {
+	if targetType == "postgresql" {
+		return madmin.NotifyPostgresSubSys
+	}
+	return "notify_" + targetType
+}
+
+// configKey returns the key of the target in the server configuration, e.g. `notify_webhook:primary`.
+func configKey(target *miniov1beta1.NotificationTarget) string {
+	return subSystem(target.Spec.ForProvider.Type) + madmin.SubSystemSeparator + target.GetTargetID()
+}
+
+// targetARN returns the ARN to be used in bucket notifications for the target.
+func targetARN(target *miniov1beta1.NotificationTarget) notification.Arn {
+	return notification.NewArn("minio", "sqs", "", target.GetTargetID(), target.Spec.ForProvider.Type)
+}
+
+// reservedKeys returns the configuration parameters that are set by dedicated fields of the target type.
+func reservedKeys(targetType string) []string {
+	return []string{enableKey, endpointKeys[targetType], authTokenKey, queueDirKey, queueLimitKey}
+}
+
+// plainConfig returns the configuration parameters of the target that are not read from Secrets.
+func plainConfig(params miniov1beta1.NotificationTargetParameters) map[string]string {
+	config := map[string]string{}
+	for key, value := range params.Config {
+		config[key] = value
+	}
+	config[enableKey] = "on"
+	config[endpointKeys[params.Type]] = params.Endpoint
+	if params.QueueDir != "" {
+		config[queueDirKey] = params.QueueDir
+	}
+	if params.QueueLimit != nil {
+		config[queueLimitKey] = strconv.FormatInt(*params.QueueLimit, 10)
+	}
+	return config
+}
+
+// secretRefs returns the configuration parameters of the target that are read from Secrets.
+func secretRefs(params miniov1beta1.NotificationTargetParameters) map[string]xpv1.LocalSecretKeySelector {
+	refs := map[string]xpv1.LocalSecretKeySelector{}
+	for key, ref := range params.SecretConfig {
+		refs[key] = ref
+	}
+	if params.AuthTokenSecretRef != nil {
+		refs[authTokenKey] = *params.AuthTokenSecretRef
+	}
+	return refs
+}
+
+// configKV returns the configuration line that sets the given parameters of the target.
+func configKV(target *miniov1beta1.NotificationTarget, config map[string]string) string {
+	return strings.Join(append([]string{configKey(target)}, minioutil.ConfigPairs(config)...), madmin.KvSpaceSeparator)
+}
+
+// findTarget returns the configuration of the target in the server configuration output, or nil if it is not configured.
+func findTarget(target *miniov1beta1.NotificationTarget, output []byte) (*madmin.SubsysConfig, error) {
+	configs, err := madmin.ParseServerConfigOutput(string(output))
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse server configuration: %w", err)
+	}
+	for i := range configs {
+		if configs[i].SubSystem == subSystem(target.Spec.ForProvider.Type) && configs[i].Target == target.GetTargetID() {
+			return &configs[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// isConfigured returns true if the target is enabled and has an endpoint.
+func isConfigured(target *miniov1beta1.NotificationTarget, observed *madmin.SubsysConfig) bool {
+	if observed == nil {
+		return false
+	}
+	if enabled, ok := observed.Lookup(enableKey); ok && enabled == "off" {
+		return false
+	}
+	endpoint, _ := observed.Lookup(endpointKeys[target.Spec.ForProvider.Type])
+	return endpoint != ""
+}
+
+// isConfigUpToDate returns true if the observed configuration has all the parameters that are not read from Secrets.
+// Parameters that are not set in the spec are left at the values of the server.
+func isConfigUpToDate(target *miniov1beta1.NotificationTarget, observed *madmin.SubsysConfig) bool {
+	for key, value := range plainConfig(target.Spec.ForProvider) {
+		if key == enableKey {
+			continue
+		}
+		if observedValue, _ := observed.Lookup(key); observedValue != value {
+			return false
+		}
+	}
+	return true
+}
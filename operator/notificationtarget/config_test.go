@@ -0,0 +1,101 @@
+package notificationtarget
+
+import (
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func newNotificationTarget(params miniov1beta1.NotificationTargetParameters) *miniov1beta1.NotificationTarget {
+	return &miniov1beta1.NotificationTarget{
+		ObjectMeta: metav1.ObjectMeta{Name: "primary", Namespace: "default"},
+		Spec: miniov1beta1.NotificationTargetSpec{
+			ManagedResourceSpec: xpv1.ManagedResourceSpec{ProviderConfigReference: &xpv1.ProviderConfigReference{Name: "minio"}},
+			ForProvider:         params,
+		},
+	}
+}
+
+func TestConfigKV(t *testing.T) {
+	target := newNotificationTarget(miniov1beta1.NotificationTargetParameters{
+		Type:       "kafka",
+		TargetID:   "events",
+		Endpoint:   "kafka-0:9092,kafka-1:9092",
+		QueueDir:   "/data/events",
+		QueueLimit: ptr.To(int64(1000)),
+		Config:     map[string]string{"topic": "bucket-events"},
+	})
+
+	assert.Equal(t, "notify_kafka:events", configKey(target))
+	assert.Equal(t, "arn:minio:sqs::events:kafka", targetARN(target).String())
+	assert.Equal(t,
+		`notify_kafka:events brokers="kafka-0:9092,kafka-1:9092" enable="on" queue_dir="/data/events" queue_limit="1000" topic="bucket-events"`,
+		configKV(target, plainConfig(target.Spec.ForProvider)))
+}
+
+func TestConfigKV_Postgres(t *testing.T) {
+	target := newNotificationTarget(miniov1beta1.NotificationTargetParameters{
+		Type:     "postgresql",
+		Endpoint: "host=db user=minio",
+	})
+
+	assert.Equal(t, "notify_postgres:primary", configKey(target))
+	assert.Equal(t, "arn:minio:sqs::primary:postgresql", targetARN(target).String())
+	assert.Equal(t, `notify_postgres:primary connection_string="host=db user=minio" enable="on"`, configKV(target, plainConfig(target.Spec.ForProvider)))
+}
+
+func TestObserveConfig(t *testing.T) {
+	target := newNotificationTarget(miniov1beta1.NotificationTargetParameters{
+		Type:       "webhook",
+		Endpoint:   "https://events.example.com",
+		QueueLimit: ptr.To(int64(1000)),
+	})
+
+	tests := map[string]struct {
+		output           string
+		expectedExists   bool
+		expectedUpToDate bool
+	}{
+		"NotConfigured": {
+			output: `notify_webhook enable=off endpoint= auth_token= queue_limit=0`,
+		},
+		"OtherTarget": {
+			output: `notify_webhook:secondary endpoint="https://events.example.com" queue_limit="1000"`,
+		},
+		"Disabled": {
+			output: `notify_webhook:primary enable=off endpoint="https://events.example.com" queue_limit="1000"`,
+		},
+		"UpToDate": {
+			output: `notify_webhook enable=off endpoint= queue_limit=0
+notify_webhook:primary endpoint="https://events.example.com" auth_token="*redacted*" queue_limit="1000"`,
+			expectedExists:   true,
+			expectedUpToDate: true,
+		},
+		"EndpointChanged": {
+			output:         `notify_webhook:primary endpoint="https://old.example.com" queue_limit="1000"`,
+			expectedExists: true,
+		},
+		"QueueLimitChanged": {
+			output:         `notify_webhook:primary endpoint="https://events.example.com" queue_limit="10"`,
+			expectedExists: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			observed, err := findTarget(target, []byte(tc.output))
+			require.NoError(t, err)
+
+			exists := isConfigured(target, observed)
+			assert.Equal(t, tc.expectedExists, exists)
+			if exists {
+				assert.Equal(t, tc.expectedUpToDate, isConfigUpToDate(target, observed))
+			}
+		})
+	}
+}
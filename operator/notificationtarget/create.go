@@ -0,0 +1,74 @@
+package notificationtarget
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/rossigee/provider-minio/operator/minioutil"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (c *notificationTargetClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("creating resource")
+
+	target, ok := mg.(*miniov1beta1.NotificationTarget)
+	if !ok {
+		return managed.ExternalCreation{}, errNotNotificationTarget
+	}
+
+	target.SetConditions(xpv1.Creating())
+	if err := c.applyConfig(ctx, target); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	c.recorder.Event(target, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Created",
+		Message: "Notification target successfully created",
+	})
+	return managed.ExternalCreation{}, nil
+}
+
+// applyConfig sets the configuration of the target on the server and records
+// whether the server has to be restarted to apply it.
+func (c *notificationTargetClient) applyConfig(ctx context.Context, target *miniov1beta1.NotificationTarget) error {
+	config := plainConfig(target.Spec.ForProvider)
+	versions := map[string]string{}
+	for key, ref := range secretRefs(target.Spec.ForProvider) {
+		value, version, err := minioutil.GetSecretValue(ctx, c.kube, target.GetNamespace(), ref)
+		if err != nil {
+			return err
+		}
+		config[key] = value
+		versions[key] = version
+	}
+
+	restart, err := c.ma.SetConfigKV(ctx, configKV(target, config))
+	if err != nil {
+		return fmt.Errorf("cannot set notification target configuration: %w", err)
+	}
+
+	target.Status.AtProvider.ARN = targetARN(target).String()
+	target.Status.AtProvider.ObservedSecretVersions = versions
+	c.setRestartRequired(target, restart)
+	return nil
+}
+
+// setRestartRequired records whether the server has to be restarted before it sends events to the target
+// and emits a Warning event if so.
+func (c *notificationTargetClient) setRestartRequired(target *miniov1beta1.NotificationTarget, restart bool) {
+	target.Status.AtProvider.RestartRequired = restart
+	if restart {
+		c.recorder.Event(target, event.Event{
+			Type:    event.TypeWarning,
+			Reason:  "RestartRequired",
+			Message: "The MinIO server must be restarted to apply the notification target configuration",
+		})
+	}
+}
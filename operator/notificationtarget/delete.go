@@ -0,0 +1,37 @@
+package notificationtarget
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (c *notificationTargetClient) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("deleting resource")
+
+	target, ok := mg.(*miniov1beta1.NotificationTarget)
+	if !ok {
+		return managed.ExternalDelete{}, errNotNotificationTarget
+	}
+
+	target.SetConditions(xpv1.Deleting())
+	restart, err := c.ma.DelConfigKV(ctx, configKey(target))
+	if err != nil {
+		return managed.ExternalDelete{}, fmt.Errorf("cannot delete notification target configuration: %w", err)
+	}
+	c.setRestartRequired(target, restart)
+
+	c.recorder.Event(target, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Deleted",
+		Message: "Notification target successfully deleted",
+	})
+	return managed.ExternalDelete{}, nil
+}
@@ -0,0 +1,7 @@
+package notificationtarget
+
+import "context"
+
+func (c *notificationTargetClient) Disconnect(ctx context.Context) error {
+	return nil
+}
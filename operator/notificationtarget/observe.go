@@ -0,0 +1,71 @@
+package notificationtarget
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/rossigee/provider-minio/operator/minioutil"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (c *notificationTargetClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("observing resource")
+
+	target, ok := mg.(*miniov1beta1.NotificationTarget)
+	if !ok {
+		return managed.ExternalObservation{}, errNotNotificationTarget
+	}
+
+	output, err := c.ma.GetConfigKV(ctx, subSystem(target.Spec.ForProvider.Type))
+	if err != nil {
+		return managed.ExternalObservation{}, fmt.Errorf("cannot get notification target configuration: %w", err)
+	}
+
+	observed, err := findTarget(target, output)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if !isConfigured(target, observed) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	target.Status.AtProvider.ARN = targetARN(target).String()
+
+	// Passwords and tokens of notification targets are redacted in the GetConfigKV output,
+	// a changed Secret is noticed by its resource version instead.
+	versions, err := minioutil.SecretVersions(ctx, c.kube, target.GetNamespace(), secretRefs(target.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if !isConfigUpToDate(target, observed) || !maps.Equal(versions, target.Status.AtProvider.ObservedSecretVersions) {
+		target.SetConditions(miniov1beta1.Updating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	if target.Status.AtProvider.RestartRequired {
+		c.clearRestartRequired(ctx, target)
+	}
+	target.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+// clearRestartRequired clears the RestartRequired flag once the server advertises the target.
+// The stored configuration already matches right after it has been set, but the server only
+// loads the target after a restart. The flag is kept if the server info cannot be queried.
+func (c *notificationTargetClient) clearRestartRequired(ctx context.Context, target *miniov1beta1.NotificationTarget) {
+	info, err := c.ma.ServerInfo(ctx)
+	if err != nil {
+		ctrl.LoggerFrom(ctx).V(1).Info("cannot get server info, keeping restartRequired", "error", err.Error())
+		return
+	}
+	if minioutil.HasTargetARN(info.SQSARN, targetARN(target)) {
+		target.Status.AtProvider.RestartRequired = false
+	}
+}
@@ -0,0 +1,83 @@
+package notificationtarget
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// newObserveTestClient returns a client for a fake server that stores the given configuration
+// output and advertises the given notification target ARNs.
+func newObserveTestClient(t *testing.T, output string, advertised []string) *notificationTargetClient {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/minio/admin/v3/get-config-kv":
+			encrypted, err := madmin.EncryptData("password", []byte(output))
+			require.NoError(t, err)
+			_, _ = w.Write(encrypted)
+		case "/minio/admin/v3/info":
+			_ = json.NewEncoder(w).Encode(madmin.InfoMessage{SQSARN: advertised})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	parsed, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	ma, err := madmin.New(parsed.Host, "admin", "password", false)
+	require.NoError(t, err)
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	return &notificationTargetClient{ma: ma, kube: fake.NewClientBuilder().WithScheme(scheme).Build()}
+}
+
+func TestObserve_RestartRequired(t *testing.T) {
+	output := `notify_webhook:primary endpoint="https://events.example.com"`
+
+	tests := map[string]struct {
+		advertised      []string
+		restartRequired bool
+		expected        bool
+	}{
+		"GivenConfigStoredButTargetNotLoaded_ThenKeepFlag": {
+			advertised:      []string{"arn:minio:sqs::secondary:webhook"},
+			restartRequired: true,
+			expected:        true,
+		},
+		"GivenTargetLoaded_ThenClearFlag": {
+			advertised:      []string{"arn:minio:sqs:us-east-1:primary:webhook"},
+			restartRequired: true,
+			expected:        false,
+		},
+		"GivenNoRestartRequired_ThenFlagUnset": {
+			expected: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			target := newNotificationTarget(miniov1beta1.NotificationTargetParameters{
+				Type:     "webhook",
+				Endpoint: "https://events.example.com",
+			})
+			target.Status.AtProvider.RestartRequired = tc.restartRequired
+
+			obs, err := newObserveTestClient(t, output, tc.advertised).Observe(t.Context(), target)
+			require.NoError(t, err)
+			assert.True(t, obs.ResourceUpToDate)
+			assert.Equal(t, tc.expected, target.Status.AtProvider.RestartRequired)
+		})
+	}
+}
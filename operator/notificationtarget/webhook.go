@@ -0,0 +1,123 @@
+package notificationtarget
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ admission.Validator[*miniov1beta1.NotificationTarget] = &Validator{}
+
+var (
+	targetIDPattern  = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	configKeyPattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+)
+
+// Validator validates admission requests.
+type Validator struct {
+	log logr.Logger
+}
+
+// ValidateCreate implements admission.Validator.
+func (v *Validator) ValidateCreate(_ context.Context, target *miniov1beta1.NotificationTarget) (admission.Warnings, error) {
+	v.log.V(1).Info("Validate create")
+	return nil, validateTarget(target)
+}
+
+// ValidateUpdate implements admission.Validator.
+func (v *Validator) ValidateUpdate(_ context.Context, oldTarget, newTarget *miniov1beta1.NotificationTarget) (admission.Warnings, error) {
+	v.log.V(1).Info("Validate update")
+
+	if newTarget.GetDeletionTimestamp() != nil {
+		return nil, nil
+	}
+
+	path := field.NewPath("spec", "forProvider")
+	if newTarget.Spec.ForProvider.Type != oldTarget.Spec.ForProvider.Type {
+		return nil, field.Invalid(path.Child("type"), newTarget.Spec.ForProvider.Type, "Changing the type is not allowed")
+	}
+	if newTarget.GetTargetID() != oldTarget.GetTargetID() {
+		return nil, field.Invalid(path.Child("targetId"), newTarget.Spec.ForProvider.TargetID, "Changing the target ID is not allowed")
+	}
+
+	return nil, validateTarget(newTarget)
+}
+
+// ValidateDelete implements admission.Validator.
+func (v *Validator) ValidateDelete(_ context.Context, _ *miniov1beta1.NotificationTarget) (admission.Warnings, error) {
+	v.log.V(1).Info("validate delete (noop)")
+	return nil, nil
+}
+
+func validateTarget(target *miniov1beta1.NotificationTarget) error {
+	providerConfigRef := target.Spec.ProviderConfigReference
+	if providerConfigRef == nil || providerConfigRef.Name == "" {
+		return field.Invalid(field.NewPath("spec", "providerConfigRef", "name"), "null", "Provider config is required")
+	}
+
+	params := target.Spec.ForProvider
+	path := field.NewPath("spec", "forProvider")
+	if _, ok := endpointKeys[params.Type]; !ok {
+		return field.NotSupported(path.Child("type"), params.Type, sortedTypes())
+	}
+	if !targetIDPattern.MatchString(target.GetTargetID()) {
+		return field.Invalid(path.Child("targetId"), target.GetTargetID(), "Target ID must only contain letters, digits, '-' and '_'")
+	}
+	if params.Endpoint == "" {
+		return field.Required(path.Child("endpoint"), "Endpoint is required")
+	}
+	if strings.Contains(params.Endpoint, madmin.KvDoubleQuote) {
+		return field.Invalid(path.Child("endpoint"), params.Endpoint, "Endpoint must not contain double quotes")
+	}
+	if strings.Contains(params.QueueDir, madmin.KvDoubleQuote) {
+		return field.Invalid(path.Child("queueDir"), params.QueueDir, "Queue directory must not contain double quotes")
+	}
+	if params.AuthTokenSecretRef != nil && params.Type != "webhook" {
+		return field.Forbidden(path.Child("authTokenSecretRef"), "Auth token is only supported by webhook targets")
+	}
+
+	reserved := reservedKeys(params.Type)
+	for key, value := range params.Config {
+		if err := validateConfigKey(path.Child("config").Key(key), key, reserved); err != nil {
+			return err
+		}
+		if strings.Contains(value, madmin.KvDoubleQuote) {
+			return field.Invalid(path.Child("config").Key(key), value, "Value must not contain double quotes")
+		}
+	}
+	for key := range params.SecretConfig {
+		if err := validateConfigKey(path.Child("secretConfig").Key(key), key, reserved); err != nil {
+			return err
+		}
+		if _, ok := params.Config[key]; ok {
+			return field.Duplicate(path.Child("secretConfig").Key(key), key)
+		}
+	}
+	return nil
+}
+
+func validateConfigKey(path *field.Path, key string, reserved []string) error {
+	if !configKeyPattern.MatchString(key) {
+		return field.Invalid(path, key, "Key must only contain lowercase letters, digits and '_'")
+	}
+	if slices.Contains(reserved, key) {
+		return field.Forbidden(path, "Key is set by a dedicated field")
+	}
+	return nil
+}
+
+func sortedTypes() []string {
+	types := make([]string, 0, len(endpointKeys))
+	for targetType := range endpointKeys {
+		types = append(types, targetType)
+	}
+	slices.Sort(types)
+	return types
+}
@@ -0,0 +1,82 @@
+package notificationtarget
+
+import (
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTarget(t *testing.T) {
+	tokenRef := &xpv1.LocalSecretKeySelector{LocalSecretReference: xpv1.LocalSecretReference{Name: "webhook"}, Key: "token"}
+
+	tests := map[string]struct {
+		params  miniov1beta1.NotificationTargetParameters
+		wantErr string
+	}{
+		"Webhook": {
+			params: miniov1beta1.NotificationTargetParameters{Type: "webhook", Endpoint: "https://events.example.com", AuthTokenSecretRef: tokenRef},
+		},
+		"KafkaWithConfig": {
+			params: miniov1beta1.NotificationTargetParameters{
+				Type:         "kafka",
+				Endpoint:     "kafka:9092",
+				Config:       map[string]string{"topic": "events"},
+				SecretConfig: map[string]xpv1.LocalSecretKeySelector{"sasl_password": *tokenRef},
+			},
+		},
+		"UnknownType": {
+			params:  miniov1beta1.NotificationTargetParameters{Type: "sqs", Endpoint: "x"},
+			wantErr: "Unsupported value",
+		},
+		"InvalidTargetID": {
+			params:  miniov1beta1.NotificationTargetParameters{Type: "webhook", TargetID: "a:b", Endpoint: "x"},
+			wantErr: "Target ID must only contain",
+		},
+		"MissingEndpoint": {
+			params:  miniov1beta1.NotificationTargetParameters{Type: "webhook"},
+			wantErr: "Endpoint is required",
+		},
+		"QuotedQueueDir": {
+			params:  miniov1beta1.NotificationTargetParameters{Type: "webhook", Endpoint: "https://events.example.com", QueueDir: `/data/"events"`},
+			wantErr: "must not contain double quotes",
+		},
+		"AuthTokenForKafka": {
+			params:  miniov1beta1.NotificationTargetParameters{Type: "kafka", Endpoint: "kafka:9092", AuthTokenSecretRef: tokenRef},
+			wantErr: "only supported by webhook targets",
+		},
+		"ReservedConfigKey": {
+			params:  miniov1beta1.NotificationTargetParameters{Type: "kafka", Endpoint: "kafka:9092", Config: map[string]string{"brokers": "other:9092"}},
+			wantErr: "set by a dedicated field",
+		},
+		"InvalidConfigKey": {
+			params:  miniov1beta1.NotificationTargetParameters{Type: "kafka", Endpoint: "kafka:9092", Config: map[string]string{"Topic": "events"}},
+			wantErr: "Key must only contain",
+		},
+		"QuotedConfigValue": {
+			params:  miniov1beta1.NotificationTargetParameters{Type: "kafka", Endpoint: "kafka:9092", Config: map[string]string{"topic": `a"b`}},
+			wantErr: "must not contain double quotes",
+		},
+		"KeyInConfigAndSecretConfig": {
+			params: miniov1beta1.NotificationTargetParameters{
+				Type:         "kafka",
+				Endpoint:     "kafka:9092",
+				Config:       map[string]string{"sasl_password": "plain"},
+				SecretConfig: map[string]xpv1.LocalSecretKeySelector{"sasl_password": *tokenRef},
+			},
+			wantErr: "Duplicate value",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateTarget(newNotificationTarget(tc.params))
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
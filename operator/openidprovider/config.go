@@ -0,0 +1,98 @@
+package openidprovider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/rossigee/provider-minio/operator/minioutil"
+)
+
+const (
+	configURLKey    = "config_url"
+	clientIDKey     = "client_id"
+	clientSecretKey = "client_secret"
+	claimNameKey    = "claim_name"
+	scopesKey       = "scopes"
+	rolePolicyKey   = "role_policy"
+)
+
+// reservedKeys are the configuration parameters that are set by dedicated fields.
+var reservedKeys = []string{configURLKey, clientIDKey, clientSecretKey, claimNameKey, scopesKey, rolePolicyKey}
+
+// plainConfig returns the configuration parameters of the provider that are not read from Secrets.
+func plainConfig(params miniov1beta1.OpenIDProviderParameters) map[string]string {
+	config := map[string]string{}
+	for key, value := range params.Config {
+		config[key] = value
+	}
+	config[configURLKey] = params.ConfigURL
+	config[clientIDKey] = params.ClientID
+	if params.ClaimName != "" {
+		config[claimNameKey] = params.ClaimName
+	}
+	if len(params.Scopes) > 0 {
+		config[scopesKey] = strings.Join(params.Scopes, ",")
+	}
+	if params.RolePolicy != "" {
+		config[rolePolicyKey] = params.RolePolicy
+	}
+	return config
+}
+
+// configData returns the configuration parameters in the format of `mc admin idp openid add`.
+func configData(config map[string]string) string {
+	return strings.Join(minioutil.ConfigPairs(config), madmin.KvSpaceSeparator)
+}
+
+// findProvider returns the provider in the list of identity providers of the server, or nil if it is not configured.
+func findProvider(provider *miniov1beta1.OpenIDProvider, items []madmin.IDPListItem) *madmin.IDPListItem {
+	for i := range items {
+		if items[i].Name == provider.GetProviderName() {
+			return &items[i]
+		}
+	}
+	return nil
+}
+
+// isConfigUpToDate returns true if the observed configuration has all the parameters that are not read from Secrets.
+// Parameters set by environment variables on the server are skipped, the server ignores the configured values.
+func isConfigUpToDate(provider *miniov1beta1.OpenIDProvider, idpConfig madmin.IDPConfig) bool {
+	observed, envKeys := minioutil.IDPConfigValues(idpConfig)
+	for key, value := range plainConfig(provider.Spec.ForProvider) {
+		if slices.Contains(envKeys, key) {
+			continue
+		}
+		if key == scopesKey {
+			if !slices.Equal(minioutil.SplitList(value), minioutil.SplitList(observed[key])) {
+				return false
+			}
+			continue
+		}
+		if observed[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// getClientSecret returns the client secret together with the resource version of the Secret holding it.
+// It returns empty strings if the provider has no client secret.
+func (c *openIDProviderClient) getClientSecret(ctx context.Context, provider *miniov1beta1.OpenIDProvider) (string, string, error) {
+	ref := provider.Spec.ForProvider.ClientSecretSecretRef
+	if ref == nil {
+		return "", "", nil
+	}
+
+	value, version, err := minioutil.GetSecretValue(ctx, c.kube, provider.GetNamespace(), *ref)
+	if err != nil {
+		return "", "", err
+	}
+	if value == "" {
+		return "", "", fmt.Errorf("secret %q has no value for key %q", ref.Name, ref.Key)
+	}
+	return value, version, nil
+}
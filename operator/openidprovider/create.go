@@ -0,0 +1,71 @@
+package openidprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (c *openIDProviderClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("creating resource")
+
+	provider, ok := mg.(*miniov1beta1.OpenIDProvider)
+	if !ok {
+		return managed.ExternalCreation{}, errNotOpenIDProvider
+	}
+
+	provider.SetConditions(xpv1.Creating())
+	if err := c.applyConfig(ctx, provider, false); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	c.recorder.Event(provider, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Created",
+		Message: "OpenID provider successfully created",
+	})
+	return managed.ExternalCreation{}, nil
+}
+
+// applyConfig adds or updates the configuration of the provider on the server and records
+// whether the server has to be restarted to apply it.
+func (c *openIDProviderClient) applyConfig(ctx context.Context, provider *miniov1beta1.OpenIDProvider, update bool) error {
+	config := plainConfig(provider.Spec.ForProvider)
+	clientSecret, version, err := c.getClientSecret(ctx, provider)
+	if err != nil {
+		return err
+	}
+	if clientSecret != "" {
+		config[clientSecretKey] = clientSecret
+	}
+
+	restart, err := c.ma.AddOrUpdateIDPConfig(ctx, madmin.OpenidIDPCfg, provider.GetProviderName(), configData(config), update)
+	if err != nil {
+		return fmt.Errorf("cannot set OpenID provider configuration: %w", err)
+	}
+
+	provider.Status.AtProvider.ObservedSecretVersion = version
+	c.setRestartRequired(provider, restart)
+	return nil
+}
+
+// setRestartRequired records whether the server has to be restarted to load the OpenID provider
+// and emits a Warning event if so.
+func (c *openIDProviderClient) setRestartRequired(provider *miniov1beta1.OpenIDProvider, restart bool) {
+	provider.Status.AtProvider.RestartRequired = restart
+	if restart {
+		c.recorder.Event(provider, event.Event{
+			Type:    event.TypeWarning,
+			Reason:  "RestartRequired",
+			Message: "The MinIO server must be restarted to apply the OpenID provider configuration",
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package openidprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (c *openIDProviderClient) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("deleting resource")
+
+	provider, ok := mg.(*miniov1beta1.OpenIDProvider)
+	if !ok {
+		return managed.ExternalDelete{}, errNotOpenIDProvider
+	}
+
+	provider.SetConditions(xpv1.Deleting())
+	restart, err := c.ma.DeleteIDPConfig(ctx, madmin.OpenidIDPCfg, provider.GetProviderName())
+	if err != nil {
+		return managed.ExternalDelete{}, fmt.Errorf("cannot delete OpenID provider configuration: %w", err)
+	}
+	c.setRestartRequired(provider, restart)
+
+	c.recorder.Event(provider, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Deleted",
+		Message: "OpenID provider successfully deleted",
+	})
+	return managed.ExternalDelete{}, nil
+}
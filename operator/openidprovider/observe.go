@@ -0,0 +1,60 @@
+package openidprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/rossigee/provider-minio/operator/minioutil"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (c *openIDProviderClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("observing resource")
+
+	provider, ok := mg.(*miniov1beta1.OpenIDProvider)
+	if !ok {
+		return managed.ExternalObservation{}, errNotOpenIDProvider
+	}
+
+	items, err := c.ma.ListIDPConfig(ctx, madmin.OpenidIDPCfg)
+	if err != nil {
+		return managed.ExternalObservation{}, fmt.Errorf("cannot list OpenID providers: %w", err)
+	}
+
+	item := findProvider(provider, items)
+	if item == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	idpConfig, err := c.ma.GetIDPConfig(ctx, madmin.OpenidIDPCfg, provider.GetProviderName())
+	if err != nil {
+		return managed.ExternalObservation{}, fmt.Errorf("cannot get OpenID provider configuration: %w", err)
+	}
+	if observed, _ := minioutil.IDPConfigValues(idpConfig); observed[configURLKey] == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	provider.Status.AtProvider.RoleARN = item.RoleARN
+	provider.Status.AtProvider.Enabled = item.Enabled
+	c.observeReachable(ctx, provider)
+
+	// The client secret is not compared, changes are detected by the resource version of the Secret.
+	_, version, err := c.getClientSecret(ctx, provider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if !isConfigUpToDate(provider, idpConfig) || version != provider.Status.AtProvider.ObservedSecretVersion {
+		provider.SetConditions(miniov1beta1.Updating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	provider.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
@@ -0,0 +1,171 @@
+package openidprovider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newOpenIDProvider(params miniov1beta1.OpenIDProviderParameters) *miniov1beta1.OpenIDProvider {
+	return &miniov1beta1.OpenIDProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "keycloak", Namespace: "default"},
+		Spec: miniov1beta1.OpenIDProviderSpec{
+			ManagedResourceSpec: xpv1.ManagedResourceSpec{ProviderConfigReference: &xpv1.ProviderConfigReference{Name: "minio"}},
+			ForProvider:         params,
+		},
+	}
+}
+
+func newKube(t *testing.T) client.Client {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "keycloak", Namespace: "default"},
+		Data:       map[string][]byte{"clientSecret": []byte("secret")},
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+}
+
+type eventRecorder struct {
+	events []event.Event
+}
+
+func (r *eventRecorder) Event(_ runtime.Object, e event.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *eventRecorder) WithAnnotations(_ ...string) event.Recorder {
+	return r
+}
+
+// newAdminClient returns an admin client for a fake server that serves the given identity providers.
+func newAdminClient(t *testing.T, items []madmin.IDPListItem, idpConfig *madmin.IDPConfig) *madmin.AdminClient {
+	writeEncrypted := func(w http.ResponseWriter, v any) {
+		data, err := json.Marshal(v)
+		require.NoError(t, err)
+		encrypted, err := madmin.EncryptData("password", data)
+		require.NoError(t, err)
+		_, _ = w.Write(encrypted)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/minio/admin/v3/idp-config/openid":
+			writeEncrypted(w, items)
+		case "/minio/admin/v3/idp-config/openid/keycloak":
+			writeEncrypted(w, idpConfig)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	parsed, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	ma, err := madmin.New(parsed.Host, "admin", "password", false)
+	require.NoError(t, err)
+	return ma
+}
+
+func TestObserve(t *testing.T) {
+	kube := newKube(t)
+	discoveryURL, _ := newDiscoveryServer(t)
+	secret := &corev1.Secret{}
+	require.NoError(t, kube.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "keycloak"}, secret))
+
+	items := []madmin.IDPListItem{{Type: "openid", Name: "keycloak", Enabled: true, RoleARN: "arn:minio:iam:::role/keycloak"}}
+
+	tests := map[string]struct {
+		items             []madmin.IDPListItem
+		configURLPath     string
+		clientID          string
+		observedVersion   string
+		expectedExists    bool
+		expectedUpToDate  bool
+		expectedReachable corev1.ConditionStatus
+	}{
+		"NotFound": {},
+		"UpToDate": {
+			items:             items,
+			configURLPath:     "/.well-known/openid-configuration",
+			clientID:          "minio",
+			observedVersion:   secret.GetResourceVersion(),
+			expectedExists:    true,
+			expectedUpToDate:  true,
+			expectedReachable: corev1.ConditionTrue,
+		},
+		"ClientIDChanged": {
+			items:             items,
+			configURLPath:     "/.well-known/openid-configuration",
+			clientID:          "other",
+			observedVersion:   secret.GetResourceVersion(),
+			expectedExists:    true,
+			expectedReachable: corev1.ConditionTrue,
+		},
+		"SecretChanged": {
+			items:             items,
+			configURLPath:     "/.well-known/openid-configuration",
+			clientID:          "minio",
+			observedVersion:   "1",
+			expectedExists:    true,
+			expectedReachable: corev1.ConditionTrue,
+		},
+		"Unreachable": {
+			items:             items,
+			configURLPath:     "/missing",
+			clientID:          "minio",
+			observedVersion:   secret.GetResourceVersion(),
+			expectedExists:    true,
+			expectedUpToDate:  true,
+			expectedReachable: corev1.ConditionFalse,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			idpConfig := &madmin.IDPConfig{Type: "openid", Name: "keycloak"}
+			ma := newAdminClient(t, tc.items, idpConfig)
+			configURL := discoveryURL + tc.configURLPath
+			idpConfig.Info = []madmin.IDPCfgInfo{
+				{Key: configURLKey, Value: configURL, IsCfg: true},
+				{Key: clientIDKey, Value: "minio", IsCfg: true},
+				{Key: scopesKey, Value: "groups,openid", IsCfg: true},
+				{Key: claimNameKey, Value: "policy", IsCfg: true, IsEnv: true},
+			}
+
+			providerClient := &openIDProviderClient{ma: ma, kube: kube, recorder: &eventRecorder{}}
+			provider := newOpenIDProvider(miniov1beta1.OpenIDProviderParameters{
+				ConfigURL:             configURL,
+				ClientID:              tc.clientID,
+				ClientSecretSecretRef: &xpv1.LocalSecretKeySelector{LocalSecretReference: xpv1.LocalSecretReference{Name: "keycloak"}, Key: "clientSecret"},
+				ClaimName:             "groups",
+				Scopes:                []string{"openid", "groups"},
+			})
+			provider.Status.AtProvider.ObservedSecretVersion = tc.observedVersion
+
+			observation, err := providerClient.Observe(context.Background(), provider)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedExists, observation.ResourceExists)
+			assert.Equal(t, tc.expectedUpToDate, observation.ResourceUpToDate)
+			if tc.expectedExists {
+				assert.Equal(t, "arn:minio:iam:::role/keycloak", provider.Status.AtProvider.RoleARN)
+				assert.Equal(t, tc.expectedReachable, provider.GetCondition(miniov1beta1.TypeReachable).Status)
+			}
+		})
+	}
+}
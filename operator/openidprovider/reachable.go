@@ -0,0 +1,83 @@
+package openidprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// reachabilityInterval is the minimum time between two fetches of the discovery document of a provider.
+const reachabilityInterval = 10 * time.Minute
+
+// unreachableMessage is the message of the Unreachable condition and event.
+// The error is only logged, the response of an arbitrary URL is not copied into the status.
+const unreachableMessage = "The discovery document of the OpenID provider cannot be fetched"
+
+// httpClient fetches the discovery document of the identity providers.
+// Redirects are not followed, so the request cannot be forwarded to another host.
+var httpClient = &http.Client{
+	Timeout: 5 * time.Second,
+	CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// checkReachable returns an error if the discovery document at the given https URL cannot be fetched.
+func checkReachable(ctx context.Context, configURL string) error {
+	parsed, err := url.Parse(configURL)
+	if err != nil {
+		return fmt.Errorf("invalid config URL: %w", err)
+	}
+	if parsed.Scheme != "https" {
+		return errors.New("config URL is not an https URL")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, parsed.String(), nil)
+	if err != nil {
+		return fmt.Errorf("invalid config URL: %w", err)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("cannot fetch discovery document: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("cannot fetch discovery document: %s", resp.Status)
+	}
+	return nil
+}
+
+// observeReachable sets the Reachable condition of the provider and emits a Warning event when it becomes unreachable.
+// MinIO does not report the state of OpenID providers, so the discovery document is fetched by the provider pod,
+// at most once per reachabilityInterval.
+func (c *openIDProviderClient) observeReachable(ctx context.Context, provider *miniov1beta1.OpenIDProvider) {
+	checkedAt := provider.Status.AtProvider.ReachabilityCheckedAt
+	if checkedAt != nil && time.Since(checkedAt.Time) < reachabilityInterval {
+		return
+	}
+	now := metav1.Now()
+	provider.Status.AtProvider.ReachabilityCheckedAt = &now
+
+	err := checkReachable(ctx, provider.Spec.ForProvider.ConfigURL)
+	if err == nil {
+		provider.SetConditions(miniov1beta1.Reachable())
+		return
+	}
+
+	ctrl.LoggerFrom(ctx).V(1).Info("OpenID provider is not reachable", "error", err.Error())
+	if provider.GetCondition(miniov1beta1.TypeReachable).Reason != miniov1beta1.Unreachable("").Reason {
+		c.recorder.Event(provider, event.Event{
+			Type:    event.TypeWarning,
+			Reason:  "Unreachable",
+			Message: unreachableMessage,
+		})
+	}
+	provider.SetConditions(miniov1beta1.Unreachable(unreachableMessage))
+}
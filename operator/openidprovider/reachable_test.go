@@ -0,0 +1,135 @@
+package openidprovider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// newDiscoveryServer starts an https server that serves an OpenID discovery document at /.well-known/openid-configuration
+// and redirects /redirect to it. The HTTP client of the package trusts the certificate of the server for the test.
+// The returned counter holds the number of requests.
+func newDiscoveryServer(t *testing.T) (string, *int) {
+	requests := 0
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			_, _ = w.Write([]byte(`{"issuer":"https://keycloak.example.com"}`))
+		case "/redirect":
+			http.Redirect(w, r, "/.well-known/openid-configuration", http.StatusFound)
+		default:
+			http.Error(w, "internal details", http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	transport := httpClient.Transport
+	httpClient.Transport = srv.Client().Transport
+	t.Cleanup(func() { httpClient.Transport = transport })
+	return srv.URL, &requests
+}
+
+func TestCheckReachable(t *testing.T) {
+	serverURL, _ := newDiscoveryServer(t)
+
+	tests := map[string]struct {
+		configURL     string
+		expectedError string
+	}{
+		"GivenDiscoveryDocument_ThenReachable": {
+			configURL: serverURL + "/.well-known/openid-configuration",
+		},
+		"GivenMissingDocument_ThenUnreachable": {
+			configURL:     serverURL + "/missing",
+			expectedError: "404 Not Found",
+		},
+		"GivenRedirect_ThenNotFollowed": {
+			configURL:     serverURL + "/redirect",
+			expectedError: "302 Found",
+		},
+		"GivenHTTPURL_ThenNotFetched": {
+			configURL:     "http://keycloak.example.com/.well-known/openid-configuration",
+			expectedError: "not an https URL",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := checkReachable(context.Background(), tc.configURL)
+			if tc.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestObserveReachable(t *testing.T) {
+	serverURL, requests := newDiscoveryServer(t)
+
+	tests := map[string]struct {
+		path              string
+		checkedAgo        time.Duration
+		unreachable       bool
+		expectedRequests  int
+		expectedReachable corev1.ConditionStatus
+		expectedEvents    int
+	}{
+		"GivenReachable_ThenConditionTrue": {
+			path:              "/.well-known/openid-configuration",
+			expectedRequests:  1,
+			expectedReachable: corev1.ConditionTrue,
+		},
+		"GivenUnreachable_ThenConditionFalseAndEvent": {
+			path:              "/missing",
+			expectedRequests:  1,
+			expectedReachable: corev1.ConditionFalse,
+			expectedEvents:    1,
+		},
+		"GivenAlreadyUnreachable_ThenNoEvent": {
+			path:              "/missing",
+			checkedAgo:        time.Hour,
+			unreachable:       true,
+			expectedRequests:  1,
+			expectedReachable: corev1.ConditionFalse,
+		},
+		"GivenCheckedRecently_ThenNotFetched": {
+			path:              "/missing",
+			checkedAgo:        time.Minute,
+			unreachable:       true,
+			expectedReachable: corev1.ConditionFalse,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			*requests = 0
+			recorder := &eventRecorder{}
+			providerClient := &openIDProviderClient{recorder: recorder}
+			provider := newOpenIDProvider(miniov1beta1.OpenIDProviderParameters{ConfigURL: serverURL + tc.path})
+			if tc.checkedAgo > 0 {
+				checkedAt := metav1.NewTime(time.Now().Add(-tc.checkedAgo))
+				provider.Status.AtProvider.ReachabilityCheckedAt = &checkedAt
+			}
+			if tc.unreachable {
+				provider.SetConditions(miniov1beta1.Unreachable(unreachableMessage))
+			}
+
+			providerClient.observeReachable(context.Background(), provider)
+
+			assert.Equal(t, tc.expectedRequests, *requests)
+			condition := provider.GetCondition(miniov1beta1.TypeReachable)
+			assert.Equal(t, tc.expectedReachable, condition.Status)
+			assert.NotContains(t, condition.Message, "internal details")
+			assert.Len(t, recorder.events, tc.expectedEvents)
+			assert.NotNil(t, provider.Status.AtProvider.ReachabilityCheckedAt)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package openidprovider
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (c *openIDProviderClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("updating resource")
+
+	provider, ok := mg.(*miniov1beta1.OpenIDProvider)
+	if !ok {
+		return managed.ExternalUpdate{}, errNotOpenIDProvider
+	}
+
+	if err := c.applyConfig(ctx, provider, true); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	c.recorder.Event(provider, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Updated",
+		Message: "OpenID provider successfully updated",
+	})
+	return managed.ExternalUpdate{}, nil
+}
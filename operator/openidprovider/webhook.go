@@ -0,0 +1,105 @@
+package openidprovider
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ admission.Validator[*miniov1beta1.OpenIDProvider] = &Validator{}
+
+var (
+	providerNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	configKeyPattern    = regexp.MustCompile(`^[a-z0-9_]+$`)
+)
+
+// Validator validates admission requests.
+type Validator struct {
+	log logr.Logger
+}
+
+// ValidateCreate implements admission.Validator.
+func (v *Validator) ValidateCreate(_ context.Context, provider *miniov1beta1.OpenIDProvider) (admission.Warnings, error) {
+	v.log.V(1).Info("Validate create")
+	return nil, validateProvider(provider)
+}
+
+// ValidateUpdate implements admission.Validator.
+func (v *Validator) ValidateUpdate(_ context.Context, oldProvider, newProvider *miniov1beta1.OpenIDProvider) (admission.Warnings, error) {
+	v.log.V(1).Info("Validate update")
+
+	if newProvider.GetDeletionTimestamp() != nil {
+		return nil, nil
+	}
+
+	if newProvider.GetProviderName() != oldProvider.GetProviderName() {
+		return nil, field.Invalid(field.NewPath("spec", "forProvider", "providerName"), newProvider.Spec.ForProvider.ProviderName, "Changing the provider name is not allowed")
+	}
+
+	return nil, validateProvider(newProvider)
+}
+
+// ValidateDelete implements admission.Validator.
+func (v *Validator) ValidateDelete(_ context.Context, _ *miniov1beta1.OpenIDProvider) (admission.Warnings, error) {
+	v.log.V(1).Info("validate delete (noop)")
+	return nil, nil
+}
+
+func validateProvider(provider *miniov1beta1.OpenIDProvider) error {
+	providerConfigRef := provider.Spec.ProviderConfigReference
+	if providerConfigRef == nil || providerConfigRef.Name == "" {
+		return field.Invalid(field.NewPath("spec", "providerConfigRef", "name"), "null", "Provider config is required")
+	}
+
+	params := provider.Spec.ForProvider
+	path := field.NewPath("spec", "forProvider")
+	if !providerNamePattern.MatchString(provider.GetProviderName()) {
+		return field.Invalid(path.Child("providerName"), provider.GetProviderName(), "Provider name must only contain letters, digits, '-' and '_'")
+	}
+
+	if params.ConfigURL == "" {
+		return field.Required(path.Child("configUrl"), "Config URL is required")
+	}
+	parsed, err := url.Parse(params.ConfigURL)
+	if err != nil || parsed.Scheme != "https" || parsed.Host == "" || strings.Contains(params.ConfigURL, madmin.KvDoubleQuote) {
+		return field.Invalid(path.Child("configUrl"), params.ConfigURL, "Config URL must be an https URL")
+	}
+	if params.ClientID == "" {
+		return field.Required(path.Child("clientId"), "Client ID is required")
+	}
+	if params.ClaimName != "" && params.RolePolicy != "" {
+		return field.Forbidden(path.Child("rolePolicy"), "Role policy cannot be combined with a claim name")
+	}
+	for name, value := range map[string]string{"clientId": params.ClientID, "claimName": params.ClaimName, "rolePolicy": params.RolePolicy} {
+		if strings.Contains(value, madmin.KvDoubleQuote) {
+			return field.Invalid(path.Child(name), value, "Value must not contain double quotes")
+		}
+	}
+	for i, scope := range params.Scopes {
+		if scope == "" || strings.ContainsAny(scope, `," `) {
+			return field.Invalid(path.Child("scopes").Index(i), scope, "Scope must not be empty or contain commas, spaces or double quotes")
+		}
+	}
+
+	for key, value := range params.Config {
+		keyPath := path.Child("config").Key(key)
+		if !configKeyPattern.MatchString(key) {
+			return field.Invalid(keyPath, key, "Key must only contain lowercase letters, digits and '_'")
+		}
+		if slices.Contains(reservedKeys, key) {
+			return field.Forbidden(keyPath, "Key is set by a dedicated field")
+		}
+		if strings.Contains(value, madmin.KvDoubleQuote) {
+			return field.Invalid(keyPath, value, "Value must not contain double quotes")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package openidprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateProvider(t *testing.T) {
+	tests := map[string]struct {
+		params  miniov1beta1.OpenIDProviderParameters
+		wantErr string
+	}{
+		"ClaimBased": {
+			params: miniov1beta1.OpenIDProviderParameters{ConfigURL: "https://keycloak.example.com/.well-known/openid-configuration", ClientID: "minio", ClaimName: "groups", Scopes: []string{"openid", "groups"}},
+		},
+		"RolePolicy": {
+			params: miniov1beta1.OpenIDProviderParameters{ConfigURL: "https://keycloak.example.com/.well-known/openid-configuration", ClientID: "minio", RolePolicy: "readonly"},
+		},
+		"InvalidConfigURL": {
+			params:  miniov1beta1.OpenIDProviderParameters{ConfigURL: "keycloak.example.com", ClientID: "minio"},
+			wantErr: "https URL",
+		},
+		"HTTPConfigURL": {
+			params:  miniov1beta1.OpenIDProviderParameters{ConfigURL: "http://keycloak.example.com/.well-known/openid-configuration", ClientID: "minio"},
+			wantErr: "https URL",
+		},
+		"MissingClientID": {
+			params:  miniov1beta1.OpenIDProviderParameters{ConfigURL: "https://keycloak.example.com/.well-known/openid-configuration"},
+			wantErr: "Client ID is required",
+		},
+		"ClaimNameAndRolePolicy": {
+			params:  miniov1beta1.OpenIDProviderParameters{ConfigURL: "https://keycloak.example.com/.well-known/openid-configuration", ClientID: "minio", ClaimName: "groups", RolePolicy: "readonly"},
+			wantErr: "cannot be combined",
+		},
+		"InvalidScope": {
+			params:  miniov1beta1.OpenIDProviderParameters{ConfigURL: "https://keycloak.example.com/.well-known/openid-configuration", ClientID: "minio", Scopes: []string{"openid,groups"}},
+			wantErr: "Scope must not",
+		},
+		"ReservedKey": {
+			params:  miniov1beta1.OpenIDProviderParameters{ConfigURL: "https://keycloak.example.com/.well-known/openid-configuration", ClientID: "minio", Config: map[string]string{"client_secret": "secret"}},
+			wantErr: "set by a dedicated field",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateProvider(newOpenIDProvider(tc.params))
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestValidateUpdate_Immutable(t *testing.T) {
+	v := &Validator{log: logr.Discard()}
+	oldProvider := newOpenIDProvider(miniov1beta1.OpenIDProviderParameters{ConfigURL: "https://keycloak.example.com/.well-known/openid-configuration", ClientID: "minio"})
+
+	newProvider := oldProvider.DeepCopy()
+	newProvider.Spec.ForProvider.ClientID = "other"
+	_, err := v.ValidateUpdate(context.Background(), oldProvider, newProvider)
+	assert.NoError(t, err)
+
+	newProvider = oldProvider.DeepCopy()
+	newProvider.Spec.ForProvider.ProviderName = "_"
+	_, err = v.ValidateUpdate(context.Background(), oldProvider, newProvider)
+	assert.ErrorContains(t, err, "Changing the provider name is not allowed")
+}
@@ -3,9 +3,16 @@ package operator
 import (
 	"github.com/rossigee/provider-minio/operator/bucket"
 	"github.com/rossigee/provider-minio/operator/config"
+	"github.com/rossigee/provider-minio/operator/ldappolicymapping"
+	"github.com/rossigee/provider-minio/operator/ldapprovider"
+	"github.com/rossigee/provider-minio/operator/logtarget"
 	"github.com/rossigee/provider-minio/operator/notificationconfiguration"
+	"github.com/rossigee/provider-minio/operator/notificationtarget"
+	"github.com/rossigee/provider-minio/operator/openidprovider"
 	"github.com/rossigee/provider-minio/operator/policy"
+	"github.com/rossigee/provider-minio/operator/serverconfig"
 	"github.com/rossigee/provider-minio/operator/serviceaccount"
+	"github.com/rossigee/provider-minio/operator/tier"
 	"github.com/rossigee/provider-minio/operator/user"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
@@ -20,6 +27,13 @@ func SetupControllers(mgr ctrl.Manager) error {
 		policy.SetupController,
 		serviceaccount.SetupController,
 		notificationconfiguration.SetupController,
+		notificationtarget.SetupController,
+		tier.SetupController,
+		serverconfig.SetupController,
+		logtarget.SetupController,
+		openidprovider.SetupController,
+		ldapprovider.SetupController,
+		ldappolicymapping.SetupController,
 	} {
 		if err := setup(mgr); err != nil {
 			return err
@@ -37,6 +51,13 @@ func SetupWebhooks(mgr ctrl.Manager) error {
 		policy.SetupWebhook,
 		serviceaccount.SetupWebhook,
 		notificationconfiguration.SetupWebhook,
+		notificationtarget.SetupWebhook,
+		tier.SetupWebhook,
+		serverconfig.SetupWebhook,
+		logtarget.SetupWebhook,
+		openidprovider.SetupWebhook,
+		ldapprovider.SetupWebhook,
+		ldappolicymapping.SetupWebhook,
 	} {
 		if err := setup(mgr); err != nil {
 			return err
@@ -49,7 +49,7 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, err
 	}
 
-	ma, err := minioutil.NewMinioAdmin(ctx, c.kube, config)
+	ma, err := minioutil.NewMinioAdmin(ctx, c.kube, config, miniov1beta1.PolicyKind)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,113 @@
+package serverconfig
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/rossigee/provider-minio/operator/minioutil"
+)
+
+// configKey returns the key of the sub-system or target in the server configuration, e.g. `audit_webhook:splunk`.
+func configKey(serverConfig *miniov1beta1.ServerConfig) string {
+	params := serverConfig.Spec.ForProvider
+	if params.Target == "" {
+		return params.SubSystem
+	}
+	return params.SubSystem + madmin.SubSystemSeparator + params.Target
+}
+
+// configKV returns the configuration line that sets the given parameters.
+func configKV(serverConfig *miniov1beta1.ServerConfig, config map[string]string) string {
+	return strings.Join(append([]string{configKey(serverConfig)}, minioutil.ConfigPairs(config)...), madmin.KvSpaceSeparator)
+}
+
+// findConfig returns the configuration of the sub-system target in the server configuration output, or nil if it is not configured.
+func findConfig(serverConfig *miniov1beta1.ServerConfig, output []byte) (*madmin.SubsysConfig, error) {
+	configs, err := madmin.ParseServerConfigOutput(string(output))
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse server configuration: %w", err)
+	}
+	params := serverConfig.Spec.ForProvider
+	for i := range configs {
+		if configs[i].SubSystem == params.SubSystem && configs[i].Target == params.Target {
+			return &configs[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// envOverriddenKeys returns the parameters of the spec that are overridden by environment variables on the server.
+func envOverriddenKeys(serverConfig *miniov1beta1.ServerConfig, observed *madmin.SubsysConfig) []string {
+	params := serverConfig.Spec.ForProvider
+	keys := []string{}
+	for _, kv := range observed.KV {
+		if kv.EnvOverride == nil {
+			continue
+		}
+		_, inConfig := params.Config[kv.Key]
+		_, inSecretConfig := params.SecretConfig[kv.Key]
+		if inConfig || inSecretConfig {
+			keys = append(keys, kv.Key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// isConfigUpToDate returns true if the observed configuration has the parameters of the spec.
+// Parameters overridden by environment variables are skipped.
+func isConfigUpToDate(serverConfig *miniov1beta1.ServerConfig, observed *madmin.SubsysConfig) bool {
+	overridden := envOverriddenKeys(serverConfig, observed)
+	for key, value := range serverConfig.Spec.ForProvider.Config {
+		if slices.Contains(overridden, key) {
+			continue
+		}
+		observedValue, _ := observed.Lookup(key)
+		if !sameValue(value, observedValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// sameValue returns true if both configuration values have the same meaning.
+// Booleans, durations and comma separated lists are compared semantically,
+// as the server may normalize the values that were set.
+func sameValue(desired, observed string) bool {
+	desired, observed = strings.TrimSpace(desired), strings.TrimSpace(observed)
+	if desired == observed {
+		return true
+	}
+
+	if desiredBool, ok := parseBool(desired); ok {
+		observedBool, ok := parseBool(observed)
+		return ok && desiredBool == observedBool
+	}
+
+	if desiredDuration, err := time.ParseDuration(desired); err == nil {
+		observedDuration, err := time.ParseDuration(observed)
+		return err == nil && desiredDuration == observedDuration
+	}
+
+	if strings.Contains(desired, ",") || strings.Contains(observed, ",") {
+		return slices.Equal(minioutil.SplitList(desired), minioutil.SplitList(observed))
+	}
+
+	return false
+}
+
+// parseBool parses the boolean values accepted by the server.
+func parseBool(value string) (bool, bool) {
+	switch strings.ToLower(value) {
+	case "on", "true", "enable", "enabled":
+		return true, true
+	case "off", "false", "disable", "disabled":
+		return false, true
+	}
+	return false, false
+}
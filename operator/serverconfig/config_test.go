@@ -0,0 +1,94 @@
+package serverconfig
+
+import (
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newServerConfig(params miniov1beta1.ServerConfigParameters) *miniov1beta1.ServerConfig {
+	return &miniov1beta1.ServerConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"},
+		Spec: miniov1beta1.ServerConfigSpec{
+			ManagedResourceSpec: xpv1.ManagedResourceSpec{ProviderConfigReference: &xpv1.ProviderConfigReference{Name: "minio"}},
+			ForProvider:         params,
+		},
+	}
+}
+
+func TestConfigKV(t *testing.T) {
+	serverConfig := newServerConfig(miniov1beta1.ServerConfigParameters{SubSystem: "audit_webhook", Target: "splunk"})
+	kv := configKV(serverConfig, map[string]string{"endpoint": "https://splunk.example.com", "enable": "on"})
+	assert.Equal(t, `audit_webhook:splunk enable="on" endpoint="https://splunk.example.com"`, kv)
+}
+
+func TestSameValue(t *testing.T) {
+	tests := map[string]struct {
+		desired  string
+		observed string
+		expected bool
+	}{
+		"Equal":            {desired: "100", observed: "100", expected: true},
+		"Different":        {desired: "100", observed: "200"},
+		"Bool":             {desired: "true", observed: "on", expected: true},
+		"BoolDifferent":    {desired: "enable", observed: "off"},
+		"Duration":         {desired: "1m", observed: "60s", expected: true},
+		"DurationDiffers":  {desired: "1m", observed: "30s"},
+		"List":             {desired: "b, a", observed: "a,b", expected: true},
+		"ListDifferent":    {desired: "a,b", observed: "a,c"},
+		"EmptyAndObserved": {desired: "", observed: "off"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, sameValue(tc.desired, tc.observed))
+		})
+	}
+}
+
+func TestIsConfigUpToDate(t *testing.T) {
+	output := []byte("# MINIO_API_REQUESTS_MAX=500\napi requests_max=100 cors_allow_origin=a,b stale_uploads_expiry=24h\n")
+
+	tests := map[string]struct {
+		config            map[string]string
+		expectedUpToDate  bool
+		expectedOverrides []string
+	}{
+		"UpToDate": {
+			config:            map[string]string{"cors_allow_origin": "b,a", "stale_uploads_expiry": "1440m"},
+			expectedUpToDate:  true,
+			expectedOverrides: []string{},
+		},
+		"Changed": {
+			config:            map[string]string{"stale_uploads_expiry": "12h"},
+			expectedOverrides: []string{},
+		},
+		"EnvOverridden": {
+			config:            map[string]string{"requests_max": "200"},
+			expectedUpToDate:  true,
+			expectedOverrides: []string{"requests_max"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			serverConfig := newServerConfig(miniov1beta1.ServerConfigParameters{SubSystem: "api", Config: tc.config})
+			observed, err := findConfig(serverConfig, output)
+			require.NoError(t, err)
+			require.NotNil(t, observed)
+			assert.Equal(t, tc.expectedUpToDate, isConfigUpToDate(serverConfig, observed))
+			assert.Equal(t, tc.expectedOverrides, envOverriddenKeys(serverConfig, observed))
+		})
+	}
+}
+
+func TestFindConfig_OtherTarget(t *testing.T) {
+	serverConfig := newServerConfig(miniov1beta1.ServerConfigParameters{SubSystem: "audit_webhook", Target: "splunk"})
+	observed, err := findConfig(serverConfig, []byte(`audit_webhook:elastic endpoint="https://elastic.example.com"`))
+	require.NoError(t, err)
+	assert.Nil(t, observed)
+}
@@ -0,0 +1,77 @@
+package serverconfig
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/rossigee/provider-minio/operator/minioutil"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (s *serverConfigClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("creating resource")
+
+	serverConfig, ok := mg.(*miniov1beta1.ServerConfig)
+	if !ok {
+		return managed.ExternalCreation{}, errNotServerConfig
+	}
+
+	serverConfig.SetConditions(xpv1.Creating())
+	if err := s.applyConfig(ctx, serverConfig); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	s.recorder.Event(serverConfig, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Created",
+		Message: "Server configuration successfully created",
+	})
+	return managed.ExternalCreation{}, nil
+}
+
+// applyConfig sets the configuration parameters of the spec on the server and records
+// whether the server has to be restarted to apply them.
+func (s *serverConfigClient) applyConfig(ctx context.Context, serverConfig *miniov1beta1.ServerConfig) error {
+	config := maps.Clone(serverConfig.Spec.ForProvider.Config)
+	if config == nil {
+		config = map[string]string{}
+	}
+	versions := map[string]string{}
+	for key, ref := range serverConfig.Spec.ForProvider.SecretConfig {
+		value, version, err := minioutil.GetSecretValue(ctx, s.kube, serverConfig.GetNamespace(), ref)
+		if err != nil {
+			return err
+		}
+		config[key] = value
+		versions[key] = version
+	}
+
+	restart, err := s.ma.SetConfigKV(ctx, configKV(serverConfig, config))
+	if err != nil {
+		return fmt.Errorf("cannot set server configuration: %w", err)
+	}
+
+	serverConfig.Status.AtProvider.ObservedSecretVersions = versions
+	s.setRestartRequired(serverConfig, restart)
+	return nil
+}
+
+// setRestartRequired records whether the server has to be restarted to apply the sub-system settings
+// and emits a Warning event if so.
+func (s *serverConfigClient) setRestartRequired(serverConfig *miniov1beta1.ServerConfig, restart bool) {
+	serverConfig.Status.AtProvider.RestartRequired = restart
+	if restart {
+		s.recorder.Event(serverConfig, event.Event{
+			Type:    event.TypeWarning,
+			Reason:  "RestartRequired",
+			Message: "The MinIO server must be restarted to apply the server configuration",
+		})
+	}
+}
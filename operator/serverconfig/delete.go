@@ -0,0 +1,38 @@
+package serverconfig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// Delete resets the sub-system or target to the defaults of the server.
+func (s *serverConfigClient) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("deleting resource")
+
+	serverConfig, ok := mg.(*miniov1beta1.ServerConfig)
+	if !ok {
+		return managed.ExternalDelete{}, errNotServerConfig
+	}
+
+	serverConfig.SetConditions(xpv1.Deleting())
+	restart, err := s.ma.DelConfigKV(ctx, configKey(serverConfig))
+	if err != nil {
+		return managed.ExternalDelete{}, fmt.Errorf("cannot delete server configuration: %w", err)
+	}
+	s.setRestartRequired(serverConfig, restart)
+
+	s.recorder.Event(serverConfig, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Deleted",
+		Message: "Server configuration successfully reset",
+	})
+	return managed.ExternalDelete{}, nil
+}
@@ -0,0 +1,7 @@
+package serverconfig
+
+import "context"
+
+func (c *serverConfigClient) Disconnect(ctx context.Context) error {
+	return nil
+}
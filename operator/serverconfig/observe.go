@@ -0,0 +1,58 @@
+package serverconfig
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/rossigee/provider-minio/operator/minioutil"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (s *serverConfigClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("observing resource")
+
+	serverConfig, ok := mg.(*miniov1beta1.ServerConfig)
+	if !ok {
+		return managed.ExternalObservation{}, errNotServerConfig
+	}
+
+	// The whole sub-system is fetched, the server returns an error for targets that do not exist yet.
+	// Environment variables are included to detect parameters the server does not take from its configuration
+	subSystem := serverConfig.Spec.ForProvider.SubSystem
+	output, err := s.ma.GetConfigKVWithOptions(ctx, subSystem, madmin.KVOptions{Env: true})
+	if err != nil {
+		return managed.ExternalObservation{}, fmt.Errorf("cannot get server configuration %q: %w", subSystem, err)
+	}
+
+	observed, err := findConfig(serverConfig, output)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if observed == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	serverConfig.Status.AtProvider.EnvOverriddenKeys = envOverriddenKeys(serverConfig, observed)
+
+	// Sensitive parameters such as `secret_key` are redacted in the server output,
+	// so a changed secretConfig value is detected by the resource version of its Secret.
+	versions, err := minioutil.SecretVersions(ctx, s.kube, serverConfig.GetNamespace(), serverConfig.Spec.ForProvider.SecretConfig)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if !isConfigUpToDate(serverConfig, observed) || !maps.Equal(versions, serverConfig.Status.AtProvider.ObservedSecretVersions) {
+		serverConfig.SetConditions(miniov1beta1.Updating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	serverConfig.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
@@ -0,0 +1,41 @@
+package serverconfig
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserve_TargetNotConfiguredYet(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.URL.Query().Get("key")
+		if r.URL.Path != "/minio/admin/v3/get-config-kv" || strings.Contains(key, madmin.SubSystemSeparator) {
+			// MinIO rejects targets that do not exist yet.
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"Code":"XMinioAdminConfigBadJSON","Message":"there is no target 'splunk' for subSystem 'audit_webhook'"}`))
+			return
+		}
+		encrypted, err := madmin.EncryptData("password", []byte(`audit_webhook enable=off endpoint=
+audit_webhook:elastic endpoint="https://elastic.example.com"`))
+		require.NoError(t, err)
+		_, _ = w.Write(encrypted)
+	}))
+	t.Cleanup(srv.Close)
+
+	parsed, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	ma, err := madmin.New(parsed.Host, "admin", "password", false)
+	require.NoError(t, err)
+
+	serverConfig := newServerConfig(miniov1beta1.ServerConfigParameters{SubSystem: "audit_webhook", Target: "splunk"})
+	observation, err := (&serverConfigClient{ma: ma}).Observe(t.Context(), serverConfig)
+	require.NoError(t, err)
+	assert.False(t, observation.ResourceExists)
+}
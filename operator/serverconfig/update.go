@@ -0,0 +1,32 @@
+package serverconfig
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (s *serverConfigClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("updating resource")
+
+	serverConfig, ok := mg.(*miniov1beta1.ServerConfig)
+	if !ok {
+		return managed.ExternalUpdate{}, errNotServerConfig
+	}
+
+	if err := s.applyConfig(ctx, serverConfig); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	s.recorder.Event(serverConfig, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Updated",
+		Message: "Server configuration successfully updated",
+	})
+	return managed.ExternalUpdate{}, nil
+}
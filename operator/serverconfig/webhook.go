@@ -0,0 +1,105 @@
+package serverconfig
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ admission.Validator[*miniov1beta1.ServerConfig] = &Validator{}
+
+var (
+	subSystemPattern = regexp.MustCompile(`^[a-z_]+$`)
+	targetPattern    = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	configKeyPattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+)
+
+// Validator validates admission requests.
+type Validator struct {
+	log logr.Logger
+}
+
+// ValidateCreate implements admission.Validator.
+func (v *Validator) ValidateCreate(_ context.Context, serverConfig *miniov1beta1.ServerConfig) (admission.Warnings, error) {
+	v.log.V(1).Info("Validate create")
+	return warnings(serverConfig), validateServerConfig(serverConfig)
+}
+
+// ValidateUpdate implements admission.Validator.
+func (v *Validator) ValidateUpdate(_ context.Context, oldServerConfig, newServerConfig *miniov1beta1.ServerConfig) (admission.Warnings, error) {
+	v.log.V(1).Info("Validate update")
+
+	if newServerConfig.GetDeletionTimestamp() != nil {
+		return nil, nil
+	}
+
+	path := field.NewPath("spec", "forProvider")
+	oldParams, newParams := oldServerConfig.Spec.ForProvider, newServerConfig.Spec.ForProvider
+	if newParams.SubSystem != oldParams.SubSystem {
+		return nil, field.Invalid(path.Child("subSystem"), newParams.SubSystem, "Changing the sub-system is not allowed")
+	}
+	if newParams.Target != oldParams.Target {
+		return nil, field.Invalid(path.Child("target"), newParams.Target, "Changing the target is not allowed")
+	}
+
+	return warnings(newServerConfig), validateServerConfig(newServerConfig)
+}
+
+// ValidateDelete implements admission.Validator.
+func (v *Validator) ValidateDelete(_ context.Context, _ *miniov1beta1.ServerConfig) (admission.Warnings, error) {
+	v.log.V(1).Info("validate delete (noop)")
+	return nil, nil
+}
+
+func validateServerConfig(serverConfig *miniov1beta1.ServerConfig) error {
+	providerConfigRef := serverConfig.Spec.ProviderConfigReference
+	if providerConfigRef == nil || providerConfigRef.Name == "" {
+		return field.Invalid(field.NewPath("spec", "providerConfigRef", "name"), "null", "Provider config is required")
+	}
+
+	params := serverConfig.Spec.ForProvider
+	path := field.NewPath("spec", "forProvider")
+	if !subSystemPattern.MatchString(params.SubSystem) {
+		return field.Invalid(path.Child("subSystem"), params.SubSystem, "Sub-system must only contain lowercase letters and '_'")
+	}
+	if params.Target != "" && !targetPattern.MatchString(params.Target) {
+		return field.Invalid(path.Child("target"), params.Target, "Target must only contain letters, digits, '-' and '_'")
+	}
+	if len(params.Config) == 0 && len(params.SecretConfig) == 0 {
+		return field.Required(path.Child("config"), "At least one configuration parameter is required")
+	}
+
+	for key, value := range params.Config {
+		if !configKeyPattern.MatchString(key) {
+			return field.Invalid(path.Child("config").Key(key), key, "Key must only contain lowercase letters, digits and '_'")
+		}
+		if strings.Contains(value, madmin.KvDoubleQuote) {
+			return field.Invalid(path.Child("config").Key(key), value, "Value must not contain double quotes")
+		}
+	}
+	for key := range params.SecretConfig {
+		if !configKeyPattern.MatchString(key) {
+			return field.Invalid(path.Child("secretConfig").Key(key), key, "Key must only contain lowercase letters, digits and '_'")
+		}
+		if _, ok := params.Config[key]; ok {
+			return field.Duplicate(path.Child("secretConfig").Key(key), key)
+		}
+	}
+	return nil
+}
+
+// warnings points to the dedicated resources for sub-systems that have one.
+func warnings(serverConfig *miniov1beta1.ServerConfig) admission.Warnings {
+	subSystem := serverConfig.Spec.ForProvider.SubSystem
+	if strings.HasPrefix(subSystem, "notify_") {
+		return admission.Warnings{fmt.Sprintf("%s is better managed by a NotificationTarget, which also reports its ARN", subSystem)}
+	}
+	return nil
+}
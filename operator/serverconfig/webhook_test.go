@@ -0,0 +1,86 @@
+package serverconfig
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	"github.com/go-logr/logr"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateServerConfig(t *testing.T) {
+	tests := map[string]struct {
+		params  miniov1beta1.ServerConfigParameters
+		wantErr string
+	}{
+		"Valid": {
+			params: miniov1beta1.ServerConfigParameters{SubSystem: "api", Config: map[string]string{"requests_max": "100"}},
+		},
+		"ValidTarget": {
+			params: miniov1beta1.ServerConfigParameters{SubSystem: "identity_openid", Target: "okta", SecretConfig: map[string]xpv1.LocalSecretKeySelector{
+				"client_secret": {LocalSecretReference: xpv1.LocalSecretReference{Name: "okta"}, Key: "secret"},
+			}},
+		},
+		"InvalidSubSystem": {
+			params:  miniov1beta1.ServerConfigParameters{SubSystem: "API", Config: map[string]string{"requests_max": "100"}},
+			wantErr: "Sub-system must only contain",
+		},
+		"InvalidTarget": {
+			params:  miniov1beta1.ServerConfigParameters{SubSystem: "audit_webhook", Target: "a:b", Config: map[string]string{"enable": "on"}},
+			wantErr: "Target must only contain",
+		},
+		"NoConfig": {
+			params:  miniov1beta1.ServerConfigParameters{SubSystem: "api"},
+			wantErr: "At least one configuration parameter is required",
+		},
+		"InvalidKey": {
+			params:  miniov1beta1.ServerConfigParameters{SubSystem: "api", Config: map[string]string{"requests max": "100"}},
+			wantErr: "Key must only contain",
+		},
+		"QuotedValue": {
+			params:  miniov1beta1.ServerConfigParameters{SubSystem: "api", Config: map[string]string{"requests_max": `"100"`}},
+			wantErr: "must not contain double quotes",
+		},
+		"DuplicateKey": {
+			params: miniov1beta1.ServerConfigParameters{SubSystem: "identity_openid", Config: map[string]string{"client_secret": "secret"}, SecretConfig: map[string]xpv1.LocalSecretKeySelector{
+				"client_secret": {LocalSecretReference: xpv1.LocalSecretReference{Name: "okta"}, Key: "secret"},
+			}},
+			wantErr: "Duplicate value",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateServerConfig(newServerConfig(tc.params))
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestValidateUpdate_Immutable(t *testing.T) {
+	v := &Validator{log: logr.Discard()}
+	oldServerConfig := newServerConfig(miniov1beta1.ServerConfigParameters{SubSystem: "api", Config: map[string]string{"requests_max": "100"}})
+
+	newServerConfig := oldServerConfig.DeepCopy()
+	newServerConfig.Spec.ForProvider.Config["requests_max"] = "200"
+	_, err := v.ValidateUpdate(context.Background(), oldServerConfig, newServerConfig)
+	assert.NoError(t, err)
+
+	newServerConfig = oldServerConfig.DeepCopy()
+	newServerConfig.Spec.ForProvider.SubSystem = "scanner"
+	_, err = v.ValidateUpdate(context.Background(), oldServerConfig, newServerConfig)
+	assert.ErrorContains(t, err, "Changing the sub-system is not allowed")
+}
+
+func TestWarnings(t *testing.T) {
+	warnings, err := (&Validator{log: logr.Discard()}).ValidateCreate(context.Background(),
+		newServerConfig(miniov1beta1.ServerConfigParameters{SubSystem: "notify_webhook", Config: map[string]string{"enable": "on"}}))
+	assert.NoError(t, err)
+	assert.Len(t, warnings, 1)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
@@ -34,7 +35,10 @@ func (s *serviceAccountClient) Create(ctx context.Context, mg resource.Managed)
 
 	// Get access key from spec or empty (MinIO will generate one)
 	accessKey := serviceAccount.Spec.ForProvider.AccessKey
-	secretKey := serviceAccount.Spec.ForProvider.SecretKey
+	secretKey, secretKeyVersion, err := s.getDesiredSecretKey(ctx, serviceAccount)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
 
 	// If no access key is provided but secret key is, that's an error
 	// If no access key is provided, don't provide secret key either - let MinIO generate both
@@ -45,30 +49,31 @@ func (s *serviceAccountClient) Create(ctx context.Context, mg resource.Managed)
 	// Only generate secret key if access key is also provided
 	// If neither is provided, let MinIO generate both
 	if secretKey == "" && accessKey != "" {
-		var err error
 		secretKey, err = password.Generate(64, 5, 0, false, true)
 		if err != nil {
 			return managed.ExternalCreation{}, err
 		}
 	}
 
-	// Prepare the AddServiceAccountReq
-	req := madmin.AddServiceAccountReq{
-		AccessKey:   accessKey,
-		SecretKey:   secretKey,
-		TargetUser:  serviceAccount.Spec.ForProvider.TargetUser,
-		Name:        serviceAccount.Spec.ForProvider.Name,
-		Description: serviceAccount.Spec.ForProvider.Description,
+	policy, err := s.desiredPolicy(ctx, serviceAccount)
+	if err != nil {
+		return managed.ExternalCreation{}, err
 	}
 
-	// Add policy if specified
-	if serviceAccount.Spec.ForProvider.Policy != "" {
-		req.Policy = json.RawMessage(serviceAccount.Spec.ForProvider.Policy)
-	}
+	req := newAddServiceAccountReq(serviceAccount, policy)
+	req.AccessKey = accessKey
+	req.SecretKey = secretKey
 
-	// Add expiration if specified
-	if serviceAccount.Spec.ForProvider.Expiration != nil {
-		req.Expiration = &serviceAccount.Spec.ForProvider.Expiration.Time
+	// An expired service account is recreated, which requires deleting it first
+	err = s.deleteExpiredServiceAccount(ctx, meta.GetExternalName(serviceAccount))
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if accessKey != "" && accessKey != meta.GetExternalName(serviceAccount) {
+		err = s.deleteExpiredServiceAccount(ctx, accessKey)
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
 	}
 
 	// Check if service account already exists (only if access key was specified)
@@ -96,6 +101,15 @@ func (s *serviceAccountClient) Create(ctx context.Context, mg resource.Managed)
 
 	// Update the status with the created access key (for display/status purposes only)
 	serviceAccount.Status.AtProvider.AccessKey = credentials.AccessKey
+	serviceAccount.Status.AtProvider.ObservedSecretKeyVersion = secretKeyVersion
+
+	// MinIO creates service accounts enabled. If disabling fails, the next reconcile retries it.
+	if serviceAccount.GetAccountStatus() == accountStatusOff {
+		err = s.ma.UpdateServiceAccount(ctx, credentials.AccessKey, madmin.UpdateServiceAccountReq{NewStatus: accountStatusOff})
+		if err != nil {
+			log.Info("cannot disable service account", "accessKey", credentials.AccessKey, "error", err)
+		}
+	}
 
 	connectionDetails := managed.ConnectionDetails{
 		AccessKeyName: []byte(credentials.AccessKey),
@@ -105,12 +119,33 @@ func (s *serviceAccountClient) Create(ctx context.Context, mg resource.Managed)
 	return managed.ExternalCreation{ConnectionDetails: connectionDetails}, nil
 }
 
+// newAddServiceAccountReq returns the request to create the service account with the given policy, without credentials.
+func newAddServiceAccountReq(serviceAccount *miniov1beta1.ServiceAccount, policy string) madmin.AddServiceAccountReq {
+	req := madmin.AddServiceAccountReq{
+		TargetUser:  serviceAccount.Spec.ForProvider.TargetUser,
+		Name:        serviceAccount.Spec.ForProvider.Name,
+		Description: serviceAccount.Spec.ForProvider.Description,
+	}
+
+	// Add policy if specified
+	if policy != "" {
+		req.Policy = json.RawMessage(policy)
+	}
+
+	// Add expiration if specified
+	if expiration := desiredExpiration(serviceAccount, nil, time.Now()); expiration != nil {
+		req.Expiration = expiration
+	}
+
+	return req
+}
+
 func (s *serviceAccountClient) serviceAccountExists(ctx context.Context, accessKey string) (bool, error) {
 	// Try to get info about the service account
 	_, err := s.ma.InfoServiceAccount(ctx, accessKey)
 	if err != nil {
 		// Distinguish not-found from transient errors
-		if strings.Contains(err.Error(), "does not exist") || strings.Contains(err.Error(), "not found") {
+		if isNotFound(err) {
 			return false, nil
 		}
 		// Transient error (auth, network, etc.) - propagate it to trigger a requeue
@@ -119,6 +154,11 @@ func (s *serviceAccountClient) serviceAccountExists(ctx context.Context, accessK
 	return true, nil
 }
 
+// isNotFound returns true if MinIO reports that the service account doesn't exist.
+func isNotFound(err error) bool {
+	return strings.Contains(err.Error(), "does not exist") || strings.Contains(err.Error(), "not found")
+}
+
 func (s *serviceAccountClient) emitCreationEvent(serviceAccount *miniov1beta1.ServiceAccount) {
 	s.recorder.Event(serviceAccount, event.Event{
 		Type:    event.TypeNormal,
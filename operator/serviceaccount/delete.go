@@ -28,6 +28,14 @@ func (s *serviceAccountClient) Delete(ctx context.Context, mg resource.Managed)
 		return managed.ExternalDelete{}, nil
 	}
 
+	// Delete the previous service account of a rotation that is still in its overlap period
+	if previousAccessKey, _ := getPreviousAccessKey(serviceAccount); previousAccessKey != "" {
+		err := s.ma.DeleteServiceAccount(ctx, previousAccessKey)
+		if err != nil && !isNotFound(err) {
+			return managed.ExternalDelete{}, err
+		}
+	}
+
 	// Check if the service account exists before attempting deletion
 	exists, err := s.serviceAccountExists(ctx, accessKey)
 	if err != nil {
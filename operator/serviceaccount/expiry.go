@@ -0,0 +1,111 @@
+package serviceaccount
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// noExpiration is the expiration MinIO uses for service accounts that do not expire.
+var noExpiration = time.Unix(0, 0).UTC()
+
+// observedExpiration returns the expiration reported by MinIO, or nil if the service account does not expire.
+func observedExpiration(expiration *time.Time) *time.Time {
+	if expiration == nil || expiration.Equal(noExpiration) {
+		return nil
+	}
+	return expiration
+}
+
+// desiredExpiration returns the expiration the service account should have, or nil if it should not expire.
+// With a TTL, the observed expiration is kept until less than half of the TTL remains.
+func desiredExpiration(serviceAccount *miniov1beta1.ServiceAccount, observed *time.Time, now time.Time) *time.Time {
+	params := serviceAccount.Spec.ForProvider
+	if params.TTL == nil || params.TTL.Duration <= 0 {
+		if params.Expiration == nil {
+			return nil
+		}
+		return &params.Expiration.Time
+	}
+
+	ttl := params.TTL.Duration
+	observed = observedExpiration(observed)
+	if observed != nil {
+		remaining := observed.Sub(now)
+		if remaining >= ttl/2 && remaining <= ttl {
+			return observed
+		}
+	}
+	renewed := now.Add(ttl).Truncate(time.Second)
+	return &renewed
+}
+
+// isExpired returns true if the expiration reported by MinIO has passed.
+func isExpired(expiration *time.Time, now time.Time) bool {
+	expiration = observedExpiration(expiration)
+	return expiration != nil && !expiration.After(now)
+}
+
+// canRecreate returns true if an expired service account can be replaced by one that is valid.
+// A service account whose fixed expiration has passed is left expired.
+func canRecreate(serviceAccount *miniov1beta1.ServiceAccount, now time.Time) bool {
+	expiration := desiredExpiration(serviceAccount, nil, now)
+	return expiration == nil || expiration.After(now)
+}
+
+// updateExpiryStatus sets the Expiring condition and emits a Warning event when the
+// service account enters the warning window or expires.
+func (s *serviceAccountClient) updateExpiryStatus(serviceAccount *miniov1beta1.ServiceAccount, expiration *time.Time, now time.Time) {
+	expiration = observedExpiration(expiration)
+	window := serviceAccount.Spec.ForProvider.ExpiryWarningWindow.Duration
+
+	condition := miniov1beta1.NotExpiring()
+	switch {
+	case expiration == nil:
+	case !expiration.After(now):
+		condition = miniov1beta1.Expired(*expiration)
+	case window > 0 && expiration.Sub(now) <= window:
+		condition = miniov1beta1.ExpiringSoon(*expiration)
+	}
+
+	previous := serviceAccount.GetCondition(miniov1beta1.TypeExpiring)
+	if condition.Status == corev1.ConditionTrue && previous.Reason != condition.Reason {
+		s.recorder.Event(serviceAccount, event.Event{
+			Type:    event.TypeWarning,
+			Reason:  event.Reason(condition.Reason),
+			Message: condition.Message,
+		})
+	}
+	serviceAccount.SetConditions(condition)
+}
+
+// deleteExpiredServiceAccount deletes the service account with the given access key if it has expired,
+// so that it can be recreated.
+func (s *serviceAccountClient) deleteExpiredServiceAccount(ctx context.Context, accessKey string) error {
+	if accessKey == "" {
+		return nil
+	}
+
+	info, err := s.ma.InfoServiceAccount(ctx, accessKey)
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if !isExpired(info.Expiration, time.Now()) {
+		return nil
+	}
+
+	ctrl.LoggerFrom(ctx).Info("deleting expired service account", "accessKey", accessKey)
+	err = s.ma.DeleteServiceAccount(ctx, accessKey)
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("cannot delete expired service account %q: %w", accessKey, err)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package serviceaccount
+
+import (
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type eventRecorder struct {
+	events []event.Event
+}
+
+func (r *eventRecorder) Event(_ runtime.Object, e event.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *eventRecorder) WithAnnotations(_ ...string) event.Recorder {
+	return r
+}
+
+func TestDesiredExpiration(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	fixed := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	ttl := 30 * 24 * time.Hour
+	renewed := now.Add(ttl)
+	recent := now.Add(20 * 24 * time.Hour)
+	stale := now.Add(10 * 24 * time.Hour)
+	tooLate := now.Add(40 * 24 * time.Hour)
+	past := now.Add(-time.Hour)
+
+	tests := map[string]struct {
+		params   miniov1beta1.ServiceAccountParameters
+		observed *time.Time
+		expected *time.Time
+	}{
+		"NoExpiration": {},
+		"FixedExpiration": {
+			params:   miniov1beta1.ServiceAccountParameters{Expiration: &metav1.Time{Time: fixed}},
+			observed: &stale,
+			expected: &fixed,
+		},
+		"TTLWithoutObservedExpiration": {
+			params:   miniov1beta1.ServiceAccountParameters{TTL: &metav1.Duration{Duration: ttl}},
+			expected: &renewed,
+		},
+		"TTLWithEpochExpiration": {
+			params:   miniov1beta1.ServiceAccountParameters{TTL: &metav1.Duration{Duration: ttl}},
+			observed: &noExpiration,
+			expected: &renewed,
+		},
+		"TTLKeepsRecentExpiration": {
+			params:   miniov1beta1.ServiceAccountParameters{TTL: &metav1.Duration{Duration: ttl}},
+			observed: &recent,
+			expected: &recent,
+		},
+		"TTLRenewsWhenLessThanHalfRemains": {
+			params:   miniov1beta1.ServiceAccountParameters{TTL: &metav1.Duration{Duration: ttl}},
+			observed: &stale,
+			expected: &renewed,
+		},
+		"TTLShortensExpirationBeyondTTL": {
+			params:   miniov1beta1.ServiceAccountParameters{TTL: &metav1.Duration{Duration: ttl}},
+			observed: &tooLate,
+			expected: &renewed,
+		},
+		"TTLRenewsExpired": {
+			params:   miniov1beta1.ServiceAccountParameters{TTL: &metav1.Duration{Duration: ttl}},
+			observed: &past,
+			expected: &renewed,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			sa := &miniov1beta1.ServiceAccount{Spec: miniov1beta1.ServiceAccountSpec{ForProvider: tc.params}}
+			assert.Equal(t, tc.expected, desiredExpiration(sa, tc.observed, now))
+		})
+	}
+}
+
+func TestCanRecreate(t *testing.T) {
+	now := time.Now()
+
+	assert.True(t, canRecreate(&miniov1beta1.ServiceAccount{}, now))
+	assert.True(t, canRecreate(&miniov1beta1.ServiceAccount{Spec: miniov1beta1.ServiceAccountSpec{ForProvider: miniov1beta1.ServiceAccountParameters{
+		TTL: &metav1.Duration{Duration: time.Hour},
+	}}}, now))
+	assert.True(t, canRecreate(&miniov1beta1.ServiceAccount{Spec: miniov1beta1.ServiceAccountSpec{ForProvider: miniov1beta1.ServiceAccountParameters{
+		Expiration: &metav1.Time{Time: now.Add(time.Hour)},
+	}}}, now))
+	assert.False(t, canRecreate(&miniov1beta1.ServiceAccount{Spec: miniov1beta1.ServiceAccountSpec{ForProvider: miniov1beta1.ServiceAccountParameters{
+		Expiration: &metav1.Time{Time: now.Add(-time.Hour)},
+	}}}, now))
+}
+
+func TestUpdateExpiryStatus(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	soon := now.Add(24 * time.Hour)
+	later := now.Add(30 * 24 * time.Hour)
+	past := now.Add(-time.Hour)
+
+	tests := map[string]struct {
+		expiration     *time.Time
+		wasExpiring    bool
+		expectedStatus corev1.ConditionStatus
+		expectedReason string
+		expectEvent    bool
+	}{
+		"NoExpiration": {
+			expectedStatus: corev1.ConditionFalse,
+			expectedReason: "NotExpiring",
+		},
+		"OutsideWindow": {
+			expiration:     &later,
+			expectedStatus: corev1.ConditionFalse,
+			expectedReason: "NotExpiring",
+		},
+		"EntersWindow": {
+			expiration:     &soon,
+			expectedStatus: corev1.ConditionTrue,
+			expectedReason: "ExpiringSoon",
+			expectEvent:    true,
+		},
+		"StaysInWindow": {
+			expiration:     &soon,
+			wasExpiring:    true,
+			expectedStatus: corev1.ConditionTrue,
+			expectedReason: "ExpiringSoon",
+		},
+		"Expired": {
+			expiration:     &past,
+			wasExpiring:    true,
+			expectedStatus: corev1.ConditionTrue,
+			expectedReason: "Expired",
+			expectEvent:    true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			recorder := &eventRecorder{}
+			client := &serviceAccountClient{recorder: recorder}
+			sa := &miniov1beta1.ServiceAccount{Spec: miniov1beta1.ServiceAccountSpec{ForProvider: miniov1beta1.ServiceAccountParameters{
+				ExpiryWarningWindow: metav1.Duration{Duration: 72 * time.Hour},
+			}}}
+			if tc.wasExpiring {
+				sa.SetConditions(miniov1beta1.ExpiringSoon(soon))
+			}
+
+			client.updateExpiryStatus(sa, tc.expiration, now)
+
+			condition := sa.GetCondition(miniov1beta1.TypeExpiring)
+			assert.Equal(t, tc.expectedStatus, condition.Status)
+			assert.Equal(t, tc.expectedReason, string(condition.Reason))
+			if tc.expectEvent {
+				assert.Len(t, recorder.events, 1)
+				assert.Equal(t, event.TypeWarning, recorder.events[0].Type)
+			} else {
+				assert.Empty(t, recorder.events)
+			}
+		})
+	}
+}
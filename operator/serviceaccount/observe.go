@@ -2,7 +2,8 @@ package serviceaccount
 
 import (
 	"context"
-	"strings"
+	"encoding/json"
+	"time"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
@@ -21,6 +22,11 @@ const (
 	SecretKeyName = "AWS_SECRET_ACCESS_KEY"
 )
 
+const (
+	accountStatusOn  = "on"
+	accountStatusOff = "off"
+)
+
 func (s *serviceAccountClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
 	log := ctrl.LoggerFrom(ctx)
 
@@ -42,7 +48,7 @@ func (s *serviceAccountClient) Observe(ctx context.Context, mg resource.Managed)
 	info, err := s.ma.InfoServiceAccount(ctx, accessKey)
 	if err != nil {
 		// Distinguish not-found from transient errors
-		if strings.Contains(err.Error(), "does not exist") || strings.Contains(err.Error(), "not found") {
+		if isNotFound(err) {
 			log.V(1).Info("service account doesn't exist", "accessKey", accessKey)
 			return managed.ExternalObservation{ResourceExists: false}, nil
 		}
@@ -51,6 +57,12 @@ func (s *serviceAccountClient) Observe(ctx context.Context, mg resource.Managed)
 		return managed.ExternalObservation{}, err
 	}
 
+	now := time.Now()
+	if isExpired(info.Expiration, now) && !meta.WasDeleted(serviceAccount) && canRecreate(serviceAccount, now) {
+		log.Info("service account has expired and will be recreated", "accessKey", accessKey)
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
 	// Update the status with information from MinIO
 	serviceAccount.Status.AtProvider.AccessKey = accessKey
 	serviceAccount.Status.AtProvider.AccountStatus = info.AccountStatus
@@ -58,18 +70,43 @@ func (s *serviceAccountClient) Observe(ctx context.Context, mg resource.Managed)
 	serviceAccount.Status.AtProvider.ImpliedPolicy = info.ImpliedPolicy
 	serviceAccount.Status.AtProvider.Policy = info.Policy
 
-	if info.Expiration != nil {
-		serviceAccount.Status.AtProvider.Expiration = &metav1.Time{Time: *info.Expiration}
+	if expiration := observedExpiration(info.Expiration); expiration != nil {
+		serviceAccount.Status.AtProvider.Expiration = &metav1.Time{Time: *expiration}
+	} else {
+		serviceAccount.Status.AtProvider.Expiration = nil
+	}
+	s.updateExpiryStatus(serviceAccount, info.Expiration, now)
+	updatePreviousAccessKeyStatus(serviceAccount)
+
+	desiredPolicy, err := s.desiredPolicy(ctx, serviceAccount)
+	if err != nil {
+		return managed.ExternalObservation{}, err
 	}
 
 	// Check if the service account needs to be updated
-	if !s.isUpToDate(serviceAccount, info) {
+	if !s.isUpToDate(serviceAccount, info, desiredPolicy) {
+		serviceAccount.SetConditions(miniov1beta1.Updating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	// The secret key cannot be observed, but a change of the referenced Secret can
+	changed, err := s.secretKeySecretChanged(ctx, serviceAccount)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if changed {
+		serviceAccount.SetConditions(miniov1beta1.Updating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	updateRotationStatus(serviceAccount, now)
+	if rotationDue(serviceAccount, now) || previousAccessKeyExpired(serviceAccount, now) {
 		serviceAccount.SetConditions(miniov1beta1.Updating())
 		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
 	}
 
 	// Set the condition based on account status
-	if info.AccountStatus == "enabled" {
+	if normalizeAccountStatus(info.AccountStatus) == accountStatusOn {
 		serviceAccount.SetConditions(xpv1.Available())
 	} else {
 		serviceAccount.SetConditions(miniov1beta1.Disabled())
@@ -95,26 +132,76 @@ func (s *serviceAccountClient) Observe(ctx context.Context, mg resource.Managed)
 }
 
 // isUpToDate checks if the service account configuration matches what's in MinIO
-func (s *serviceAccountClient) isUpToDate(serviceAccount *miniov1beta1.ServiceAccount, info madmin.InfoServiceAccountResp) bool {
-	// Check if policy needs updating
-	if serviceAccount.Spec.ForProvider.Policy != "" && serviceAccount.Spec.ForProvider.Policy != info.Policy {
-		return false
+func (s *serviceAccountClient) isUpToDate(serviceAccount *miniov1beta1.ServiceAccount, info madmin.InfoServiceAccountResp, desiredPolicy string) bool {
+	_, changed := serviceAccountChanges(serviceAccount, info, desiredPolicy)
+	return !changed
+}
+
+// serviceAccountChanges returns an update request containing only the fields
+// that differ between the spec and the service account in MinIO, and whether
+// there is anything to update at all.
+// MinIO cannot clear a name or description, so those are only compared when set in the spec.
+func serviceAccountChanges(serviceAccount *miniov1beta1.ServiceAccount, info madmin.InfoServiceAccountResp, desiredPolicy string) (madmin.UpdateServiceAccountReq, bool) {
+	params := serviceAccount.Spec.ForProvider
+	req := madmin.UpdateServiceAccountReq{}
+	changed := false
+
+	if params.Name != "" && params.Name != info.Name {
+		req.NewName = params.Name
+		changed = true
+	}
+
+	if params.Description != "" && params.Description != info.Description {
+		req.NewDescription = params.Description
+		changed = true
 	}
 
-	// Check expiration
-	specExpiration := serviceAccount.Spec.ForProvider.Expiration
-	infoExpiration := info.Expiration
+	if expiration := desiredExpiration(serviceAccount, info.Expiration, time.Now()); !equalExpiration(expiration, info.Expiration) {
+		if expiration == nil {
+			none := noExpiration
+			expiration = &none
+		}
+		req.NewExpiration = expiration
+		changed = true
+	}
 
-	if (specExpiration == nil) != (infoExpiration == nil) {
-		return false
+	if accountStatus := serviceAccount.GetAccountStatus(); normalizeAccountStatus(info.AccountStatus) != accountStatus {
+		req.NewStatus = accountStatus
+		changed = true
 	}
 
-	if specExpiration != nil && infoExpiration != nil {
-		if !specExpiration.Time.Equal(*infoExpiration) {
-			return false
+	if desiredPolicy != "" {
+		if info.ImpliedPolicy || !equalPolicies(desiredPolicy, info.Policy) {
+			req.NewPolicy = json.RawMessage(desiredPolicy)
+			changed = true
 		}
+	} else if !info.ImpliedPolicy && !isEmptyPolicy(info.Policy) {
+		// An empty policy removes the session policy, so the service account
+		// inherits the policies of its parent user again.
+		req.NewPolicy = json.RawMessage("{}")
+		changed = true
+	}
+
+	return req, changed
+}
+
+// equalExpiration compares the desired expiration with the one reported by MinIO.
+func equalExpiration(desired, observed *time.Time) bool {
+	observed = observedExpiration(observed)
+	if desired == nil || observed == nil {
+		return desired == nil && observed == nil
 	}
+	return desired.Equal(*observed)
+}
 
-	// All checks passed
-	return true
+// normalizeAccountStatus maps the status reported by MinIO to "on" or "off".
+// Depending on the version, MinIO reports either on/off or enabled/disabled.
+func normalizeAccountStatus(status string) string {
+	switch status {
+	case accountStatusOn, "enabled":
+		return accountStatusOn
+	case accountStatusOff, "disabled":
+		return accountStatusOff
+	}
+	return status
 }
@@ -29,7 +29,8 @@ func TestServiceAccountClient_IsUpToDate(t *testing.T) {
 				},
 			},
 			info: madmin.InfoServiceAccountResp{
-				Policy: `{"Version":"2012-10-17","Statement":[]}`,
+				AccountStatus: "on",
+				Policy:        `{"Version":"2012-10-17","Statement":[]}`,
 			},
 			expected: true,
 		},
@@ -43,7 +44,8 @@ func TestServiceAccountClient_IsUpToDate(t *testing.T) {
 				},
 			},
 			info: madmin.InfoServiceAccountResp{
-				Policy: `{"Version":"2012-10-17","Statement":[]}`,
+				AccountStatus: "on",
+				Policy:        `{"Version":"2012-10-17","Statement":[]}`,
 			},
 			expected: false,
 		},
@@ -55,10 +57,51 @@ func TestServiceAccountClient_IsUpToDate(t *testing.T) {
 				},
 			},
 			info: madmin.InfoServiceAccountResp{
-				Policy: `{"Version":"2012-10-17","Statement":[]}`,
+				AccountStatus: "on",
+				Policy:        `{"Version":"2012-10-17","Statement":[]}`,
 			},
 			expected: true,
 		},
+		{
+			name: "Policy removed from spec - needs update",
+			serviceAccount: &miniov1beta1.ServiceAccount{
+				Spec: miniov1beta1.ServiceAccountSpec{
+					ForProvider: miniov1beta1.ServiceAccountParameters{},
+				},
+			},
+			info: madmin.InfoServiceAccountResp{
+				AccountStatus: "on",
+				Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["*"]}]}`,
+			},
+			expected: false,
+		},
+		{
+			name: "Account disabled - needs update",
+			serviceAccount: &miniov1beta1.ServiceAccount{
+				Spec: miniov1beta1.ServiceAccountSpec{
+					ForProvider: miniov1beta1.ServiceAccountParameters{},
+				},
+			},
+			info: madmin.InfoServiceAccountResp{
+				AccountStatus: "off",
+			},
+			expected: false,
+		},
+		{
+			name: "Description changed - needs update",
+			serviceAccount: &miniov1beta1.ServiceAccount{
+				Spec: miniov1beta1.ServiceAccountSpec{
+					ForProvider: miniov1beta1.ServiceAccountParameters{
+						Description: "new",
+					},
+				},
+			},
+			info: madmin.InfoServiceAccountResp{
+				AccountStatus: "on",
+				Description:   "old",
+			},
+			expected: false,
+		},
 		{
 			name: "Expiration matches - up to date",
 			serviceAccount: &miniov1beta1.ServiceAccount{
@@ -69,7 +112,8 @@ func TestServiceAccountClient_IsUpToDate(t *testing.T) {
 				},
 			},
 			info: madmin.InfoServiceAccountResp{
-				Expiration: &time.Time{},
+				AccountStatus: "on",
+				Expiration:    &time.Time{},
 			},
 			expected: true,
 		},
@@ -83,8 +127,82 @@ func TestServiceAccountClient_IsUpToDate(t *testing.T) {
 				tt.info.Expiration = &expectedTime
 			}
 
-			result := client.isUpToDate(tt.serviceAccount, tt.info)
+			result := client.isUpToDate(tt.serviceAccount, tt.info, tt.serviceAccount.Spec.ForProvider.Policy)
 			assert.Equal(t, tt.expected, result)
 		})
 	}
 }
+
+func TestServiceAccountChanges(t *testing.T) {
+	expiration := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	epoch := time.Unix(0, 0).UTC()
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::bucket/*"]}]}`
+	reordered := `{"Statement":[{"Resource":["arn:aws:s3:::bucket/*"],"Action":["s3:GetObject"],"Effect":"Allow"}],"Version":"2012-10-17"}`
+
+	tests := map[string]struct {
+		params        miniov1beta1.ServiceAccountParameters
+		info          madmin.InfoServiceAccountResp
+		desiredPolicy string
+		expectedReq   madmin.UpdateServiceAccountReq
+		expectChanged bool
+	}{
+		"NoChanges": {
+			params:        miniov1beta1.ServiceAccountParameters{Name: "ci", Description: "CI", Expiration: &metav1.Time{Time: expiration}},
+			info:          madmin.InfoServiceAccountResp{AccountStatus: "on", Name: "ci", Description: "CI", Expiration: &expiration, Policy: reordered},
+			desiredPolicy: policy,
+		},
+		"OnlyNameChanged": {
+			params:        miniov1beta1.ServiceAccountParameters{Name: "new", Description: "CI"},
+			info:          madmin.InfoServiceAccountResp{AccountStatus: "on", Name: "old", Description: "CI", Policy: policy},
+			desiredPolicy: policy,
+			expectedReq:   madmin.UpdateServiceAccountReq{NewName: "new"},
+			expectChanged: true,
+		},
+		"EmptyNameAndDescriptionNotManaged": {
+			info: madmin.InfoServiceAccountResp{AccountStatus: "on", Name: "set", Description: "set", ImpliedPolicy: true, Policy: policy},
+		},
+		"ExpirationRemoved": {
+			info:          madmin.InfoServiceAccountResp{AccountStatus: "on", Expiration: &expiration, ImpliedPolicy: true},
+			expectedReq:   madmin.UpdateServiceAccountReq{NewExpiration: &epoch},
+			expectChanged: true,
+		},
+		"EpochExpirationMeansNone": {
+			info: madmin.InfoServiceAccountResp{AccountStatus: "on", Expiration: &epoch, ImpliedPolicy: true},
+		},
+		"PolicyRemoved": {
+			info:          madmin.InfoServiceAccountResp{AccountStatus: "on", Policy: policy},
+			expectedReq:   madmin.UpdateServiceAccountReq{NewPolicy: []byte("{}")},
+			expectChanged: true,
+		},
+		"PolicyAddedToImpliedPolicy": {
+			info:          madmin.InfoServiceAccountResp{AccountStatus: "on", ImpliedPolicy: true, Policy: policy},
+			desiredPolicy: policy,
+			expectedReq:   madmin.UpdateServiceAccountReq{NewPolicy: []byte(policy)},
+			expectChanged: true,
+		},
+		"AccountReEnabled": {
+			info:          madmin.InfoServiceAccountResp{AccountStatus: "off", ImpliedPolicy: true},
+			expectedReq:   madmin.UpdateServiceAccountReq{NewStatus: "on"},
+			expectChanged: true,
+		},
+		"AccountDisabled": {
+			params:        miniov1beta1.ServiceAccountParameters{AccountStatus: "off"},
+			info:          madmin.InfoServiceAccountResp{AccountStatus: "on", ImpliedPolicy: true},
+			expectedReq:   madmin.UpdateServiceAccountReq{NewStatus: "off"},
+			expectChanged: true,
+		},
+		"AccountStaysDisabled": {
+			params: miniov1beta1.ServiceAccountParameters{AccountStatus: "off"},
+			info:   madmin.InfoServiceAccountResp{AccountStatus: "off", ImpliedPolicy: true},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			sa := &miniov1beta1.ServiceAccount{Spec: miniov1beta1.ServiceAccountSpec{ForProvider: tc.params}}
+			req, changed := serviceAccountChanges(sa, tc.info, tc.desiredPolicy)
+			assert.Equal(t, tc.expectChanged, changed)
+			assert.Equal(t, tc.expectedReq, req)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package serviceaccount
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	iamPolicy "github.com/minio/pkg/iam/policy"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// desiredPolicy returns the policy document of the service account.
+// It merges the inline policy with the documents of the referenced Policy resources and canned policies.
+// It returns an empty string if none of them are set, in which case the parent user's policies apply.
+func (s *serviceAccountClient) desiredPolicy(ctx context.Context, serviceAccount *miniov1beta1.ServiceAccount) (string, error) {
+	params := serviceAccount.Spec.ForProvider
+	if len(params.PolicyRefs) == 0 && len(params.CannedPolicies) == 0 {
+		return params.Policy, nil
+	}
+
+	names := make([]string, 0, len(params.PolicyRefs)+len(params.CannedPolicies))
+	for _, ref := range params.PolicyRefs {
+		policy := &miniov1beta1.Policy{}
+		err := s.kube.Get(ctx, types.NamespacedName{Namespace: serviceAccount.GetNamespace(), Name: ref.Name}, policy)
+		if err != nil {
+			return "", fmt.Errorf("cannot get referenced policy %q: %w", ref.Name, err)
+		}
+		// Policies are created on MinIO with the name of the resource
+		names = append(names, policy.GetName())
+	}
+	names = append(names, params.CannedPolicies...)
+
+	merged := iamPolicy.Policy{}
+	if params.Policy != "" {
+		inline, err := parsePolicy(params.Policy)
+		if err != nil {
+			return "", fmt.Errorf("cannot parse policy: %w", err)
+		}
+		merged = merged.Merge(*inline)
+	}
+
+	for _, name := range names {
+		info, err := s.ma.InfoCannedPolicyV2(ctx, name)
+		if err != nil {
+			return "", fmt.Errorf("cannot get policy %q: %w", name, err)
+		}
+		doc, err := parsePolicy(string(info.Policy))
+		if err != nil {
+			return "", fmt.Errorf("cannot parse policy %q: %w", name, err)
+		}
+		merged = merged.Merge(*doc)
+	}
+
+	raw, err := json.Marshal(merged)
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+func parsePolicy(policy string) (*iamPolicy.Policy, error) {
+	return iamPolicy.ParseConfig(bytes.NewReader([]byte(policy)))
+}
+
+// equalPolicies returns true if both policy documents grant the same permissions.
+// Documents that cannot be parsed are compared verbatim.
+func equalPolicies(a, b string) bool {
+	aPol, err := parsePolicy(a)
+	if err != nil {
+		return a == b
+	}
+	bPol, err := parsePolicy(b)
+	if err != nil {
+		return a == b
+	}
+	return aPol.Equals(*bPol)
+}
+
+// isEmptyPolicy returns true if the policy document grants nothing.
+func isEmptyPolicy(policy string) bool {
+	if policy == "" {
+		return true
+	}
+	pol, err := parsePolicy(policy)
+	if err != nil {
+		return false
+	}
+	return len(pol.Statements) == 0
+}
@@ -0,0 +1,107 @@
+package serviceaccount
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	readPolicy  = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::data/*"]}]}`
+	writePolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::data/*"]}]}`
+)
+
+// newPolicyTestClient returns a serviceAccountClient backed by a fake MinIO admin API serving the given canned policies.
+func newPolicyTestClient(t *testing.T, cannedPolicies map[string]string, objects ...runtime.Object) *serviceAccountClient {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := r.URL.Query().Get("name")
+		policy, ok := cannedPolicies[name]
+		if r.URL.Path != "/minio/admin/v3/info-canned-policy" || !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(madmin.PolicyInfo{PolicyName: name, Policy: json.RawMessage(policy)})
+	}))
+	t.Cleanup(srv.Close)
+
+	parsed, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	ma, err := madmin.New(parsed.Host, "admin", "password", false)
+	require.NoError(t, err)
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, miniov1beta1.SchemeBuilder.AddToScheme(scheme))
+	kube := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objects...).Build()
+
+	return &serviceAccountClient{ma: ma, kube: kube}
+}
+
+func TestServiceAccountClient_desiredPolicy(t *testing.T) {
+	policy := &miniov1beta1.Policy{ObjectMeta: metav1.ObjectMeta{Name: "data-read", Namespace: "production"}}
+	s := newPolicyTestClient(t, map[string]string{"data-read": readPolicy, "data-write": writePolicy}, policy)
+
+	newServiceAccount := func(params miniov1beta1.ServiceAccountParameters) *miniov1beta1.ServiceAccount {
+		return &miniov1beta1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-sa", Namespace: "production"},
+			Spec:       miniov1beta1.ServiceAccountSpec{ForProvider: params},
+		}
+	}
+
+	t.Run("InlineOnly_ThenVerbatim", func(t *testing.T) {
+		got, err := s.desiredPolicy(t.Context(), newServiceAccount(miniov1beta1.ServiceAccountParameters{Policy: readPolicy}))
+		require.NoError(t, err)
+		assert.Equal(t, readPolicy, got)
+	})
+
+	t.Run("RefsAndCannedPolicies_ThenMerged", func(t *testing.T) {
+		got, err := s.desiredPolicy(t.Context(), newServiceAccount(miniov1beta1.ServiceAccountParameters{
+			PolicyRefs:     []xpv1.Reference{{Name: "data-read"}},
+			CannedPolicies: []string{"data-write"},
+		}))
+		require.NoError(t, err)
+
+		merged, err := parsePolicy(got)
+		require.NoError(t, err)
+		assert.Len(t, merged.Statements, 2)
+	})
+
+	t.Run("DuplicateStatements_ThenDropped", func(t *testing.T) {
+		got, err := s.desiredPolicy(t.Context(), newServiceAccount(miniov1beta1.ServiceAccountParameters{
+			Policy:     readPolicy,
+			PolicyRefs: []xpv1.Reference{{Name: "data-read"}},
+		}))
+		require.NoError(t, err)
+		assert.True(t, equalPolicies(readPolicy, got))
+	})
+
+	t.Run("MissingPolicyRef_ThenError", func(t *testing.T) {
+		_, err := s.desiredPolicy(t.Context(), newServiceAccount(miniov1beta1.ServiceAccountParameters{
+			PolicyRefs: []xpv1.Reference{{Name: "missing"}},
+		}))
+		assert.ErrorContains(t, err, `cannot get referenced policy "missing"`)
+	})
+
+	t.Run("MissingCannedPolicy_ThenError", func(t *testing.T) {
+		_, err := s.desiredPolicy(t.Context(), newServiceAccount(miniov1beta1.ServiceAccountParameters{
+			CannedPolicies: []string{"missing"},
+		}))
+		assert.ErrorContains(t, err, `cannot get policy "missing"`)
+	})
+}
+
+func TestEqualPolicies(t *testing.T) {
+	assert.True(t, equalPolicies(readPolicy, `{ "Statement": [{"Resource":["arn:aws:s3:::data/*"],"Action":["s3:GetObject"],"Effect":"Allow"}], "Version": "2012-10-17" }`))
+	assert.False(t, equalPolicies(readPolicy, writePolicy))
+	assert.False(t, equalPolicies("not json", readPolicy))
+}
@@ -0,0 +1,206 @@
+package serviceaccount
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	// PreviousAccessKeyName is the connection secret key holding the access key of the replaced service account during the overlap period.
+	PreviousAccessKeyName = AccessKeyName + "_PREVIOUS"
+	// PreviousSecretKeyName is the connection secret key holding the secret key of the replaced service account during the overlap period.
+	PreviousSecretKeyName = SecretKeyName + "_PREVIOUS"
+
+	// PreviousAccessKeyAnnotationKey is the annotation name where we store the access key of the replaced
+	// service account until it has been deleted.
+	PreviousAccessKeyAnnotationKey string = "minio.crossplane.io/previous-access-key"
+	// PreviousAccessKeyDeletionAnnotationKey is the annotation name where we store the time the replaced
+	// service account will be deleted.
+	PreviousAccessKeyDeletionAnnotationKey string = "minio.crossplane.io/previous-access-key-deletion"
+
+	defaultRotationOverlap = 1 * time.Hour
+)
+
+// getPreviousAccessKey returns the access key of the replaced service account and the time it will be deleted.
+// The annotations are the source of truth, the status only mirrors them.
+func getPreviousAccessKey(serviceAccount *miniov1beta1.ServiceAccount) (string, *metav1.Time) {
+	annotations := serviceAccount.GetAnnotations()
+	accessKey := annotations[PreviousAccessKeyAnnotationKey]
+	if accessKey == "" {
+		return "", nil
+	}
+	deletion, err := time.Parse(time.RFC3339, annotations[PreviousAccessKeyDeletionAnnotationKey])
+	if err != nil {
+		// Without a valid deletion time the previous service account is deleted right away.
+		return accessKey, &metav1.Time{}
+	}
+	return accessKey, &metav1.Time{Time: deletion}
+}
+
+// updatePreviousAccessKeyStatus mirrors the previous access key annotations in the status.
+func updatePreviousAccessKeyStatus(serviceAccount *miniov1beta1.ServiceAccount) {
+	status := &serviceAccount.Status.AtProvider
+	status.PreviousAccessKey, status.PreviousAccessKeyDeletion = getPreviousAccessKey(serviceAccount)
+}
+
+// updateRotationStatus starts tracking the age of the service account and computes the next rotation time.
+func updateRotationStatus(serviceAccount *miniov1beta1.ServiceAccount, now time.Time) {
+	status := &serviceAccount.Status.AtProvider
+	rotation := serviceAccount.Spec.ForProvider.Rotation
+	if rotation == nil || rotation.IntervalDays <= 0 {
+		status.NextRotation = nil
+		return
+	}
+
+	if status.LastRotation == nil {
+		t := metav1.NewTime(now)
+		status.LastRotation = &t
+	}
+	next := metav1.NewTime(status.LastRotation.AddDate(0, 0, rotation.IntervalDays))
+	status.NextRotation = &next
+}
+
+// rotationDue returns true if the service account has to be replaced.
+// A new rotation only starts after the previous service account of the last one has been deleted.
+func rotationDue(serviceAccount *miniov1beta1.ServiceAccount, now time.Time) bool {
+	nextRotation := serviceAccount.Status.AtProvider.NextRotation
+	if previousAccessKey, _ := getPreviousAccessKey(serviceAccount); nextRotation == nil || previousAccessKey != "" {
+		return false
+	}
+	return !now.Before(nextRotation.Time)
+}
+
+// previousAccessKeyExpired returns true if the overlap period of the previous service account is over.
+func previousAccessKeyExpired(serviceAccount *miniov1beta1.ServiceAccount, now time.Time) bool {
+	previousAccessKey, deletion := getPreviousAccessKey(serviceAccount)
+	if previousAccessKey == "" {
+		return false
+	}
+	return !now.Before(deletion.Time)
+}
+
+// rotate replaces the service account with a new one and returns the connection details for both.
+func (s *serviceAccountClient) rotate(ctx context.Context, serviceAccount *miniov1beta1.ServiceAccount) (managed.ConnectionDetails, error) {
+	log := ctrl.LoggerFrom(ctx)
+	previousAccessKey := meta.GetExternalName(serviceAccount)
+
+	policy, err := s.desiredPolicy(ctx, serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials, err := s.ma.AddServiceAccount(ctx, newAddServiceAccountReq(serviceAccount, policy))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	overlap := defaultRotationOverlap
+	if d := serviceAccount.Spec.ForProvider.Rotation.OverlapPeriod.Duration; d > 0 {
+		overlap = d
+	}
+	deletion := metav1.NewTime(now.Add(overlap))
+
+	// Annotations set during Update are not persisted by the reconciler, so the new
+	// external-name has to be written before the previous service account is handed off.
+	// The previous access key is written in the same update so it cannot be lost with the status.
+	meta.SetExternalName(serviceAccount, credentials.AccessKey)
+	meta.AddAnnotations(serviceAccount, map[string]string{
+		PreviousAccessKeyAnnotationKey:         previousAccessKey,
+		PreviousAccessKeyDeletionAnnotationKey: deletion.UTC().Format(time.RFC3339),
+	})
+	if err := s.kube.Update(ctx, serviceAccount); err != nil {
+		if derr := s.ma.DeleteServiceAccount(ctx, credentials.AccessKey); derr != nil {
+			log.Info("cannot delete replacement service account", "accessKey", credentials.AccessKey, "error", derr.Error())
+		}
+		return nil, fmt.Errorf("cannot persist external-name of replacement service account: %w", err)
+	}
+
+	lastRotation := metav1.NewTime(now)
+	status := &serviceAccount.Status.AtProvider
+	status.AccessKey = credentials.AccessKey
+	status.LastRotation = &lastRotation
+	updatePreviousAccessKeyStatus(serviceAccount)
+	updateRotationStatus(serviceAccount, now)
+
+	s.recorder.Event(serviceAccount, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Rotated",
+		Message: fmt.Sprintf("Service Account rotated, previous access key %s will be deleted at %s", previousAccessKey, deletion.Format(time.RFC3339)),
+	})
+
+	connectionDetails := managed.ConnectionDetails{
+		AccessKeyName:         []byte(credentials.AccessKey),
+		SecretKeyName:         []byte(credentials.SecretKey),
+		PreviousAccessKeyName: []byte(previousAccessKey),
+	}
+	// The previous secret key is only known from the connection secret.
+	if secret, err := s.getConnectionSecret(ctx, serviceAccount); err == nil && secret != nil {
+		connectionDetails[PreviousSecretKeyName] = secret.Data[SecretKeyName]
+	}
+
+	return connectionDetails, nil
+}
+
+// deletePreviousServiceAccount deletes the replaced service account and removes its credentials from the connection secret.
+func (s *serviceAccountClient) deletePreviousServiceAccount(ctx context.Context, serviceAccount *miniov1beta1.ServiceAccount) error {
+	previousAccessKey, _ := getPreviousAccessKey(serviceAccount)
+
+	exists, err := s.serviceAccountExists(ctx, previousAccessKey)
+	if err != nil {
+		return err
+	}
+	if exists {
+		err = s.ma.DeleteServiceAccount(ctx, previousAccessKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	secret, err := s.getConnectionSecret(ctx, serviceAccount)
+	if err != nil {
+		return err
+	}
+	if secret != nil {
+		delete(secret.Data, PreviousAccessKeyName)
+		delete(secret.Data, PreviousSecretKeyName)
+		if err := s.kube.Update(ctx, secret); err != nil {
+			return err
+		}
+	}
+
+	// The annotations are only removed once the previous service account is gone.
+	meta.RemoveAnnotations(serviceAccount, PreviousAccessKeyAnnotationKey, PreviousAccessKeyDeletionAnnotationKey)
+	if err := s.kube.Update(ctx, serviceAccount); err != nil {
+		return fmt.Errorf("cannot remove previous access key annotations: %w", err)
+	}
+	updatePreviousAccessKeyStatus(serviceAccount)
+
+	s.recorder.Event(serviceAccount, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "PreviousDeleted",
+		Message: fmt.Sprintf("Previous service account %s deleted after rotation", previousAccessKey),
+	})
+	return nil
+}
+
+// getConnectionSecret returns the connection secret of the service account, or nil if it has none.
+func (s *serviceAccountClient) getConnectionSecret(ctx context.Context, serviceAccount *miniov1beta1.ServiceAccount) (*corev1.Secret, error) {
+	ref := serviceAccount.GetWriteConnectionSecretToReference()
+	if ref == nil {
+		return nil, nil
+	}
+	secret := &corev1.Secret{}
+	err := s.kube.Get(ctx, types.NamespacedName{Namespace: serviceAccount.GetNamespace(), Name: ref.Name}, secret)
+	return secret, err
+}
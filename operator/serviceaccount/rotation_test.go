@@ -0,0 +1,149 @@
+package serviceaccount
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newRotatingServiceAccount(intervalDays int) *miniov1beta1.ServiceAccount {
+	return &miniov1beta1.ServiceAccount{
+		Spec: miniov1beta1.ServiceAccountSpec{
+			ForProvider: miniov1beta1.ServiceAccountParameters{
+				Rotation: &miniov1beta1.ServiceAccountRotation{IntervalDays: intervalDays},
+			},
+		},
+	}
+}
+
+func TestUpdateRotationStatus(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("WithoutRotation_ThenNoNextRotation", func(t *testing.T) {
+		sa := &miniov1beta1.ServiceAccount{}
+		next := metav1.NewTime(now)
+		sa.Status.AtProvider.NextRotation = &next
+
+		updateRotationStatus(sa, now)
+		assert.Nil(t, sa.Status.AtProvider.LastRotation)
+		assert.Nil(t, sa.Status.AtProvider.NextRotation)
+	})
+
+	t.Run("WithRotation_ThenNextRotationAfterInterval", func(t *testing.T) {
+		sa := newRotatingServiceAccount(30)
+
+		updateRotationStatus(sa, now)
+		require.NotNil(t, sa.Status.AtProvider.NextRotation)
+		assert.Equal(t, now, sa.Status.AtProvider.LastRotation.Time)
+		assert.Equal(t, now.AddDate(0, 0, 30), sa.Status.AtProvider.NextRotation.Time)
+
+		updateRotationStatus(sa, now.Add(time.Hour))
+		assert.Equal(t, now, sa.Status.AtProvider.LastRotation.Time, "last rotation must not move")
+	})
+}
+
+func TestRotationDue(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	sa := newRotatingServiceAccount(30)
+	updateRotationStatus(sa, now)
+	assert.False(t, rotationDue(sa, now.AddDate(0, 0, 29)))
+	assert.True(t, rotationDue(sa, now.AddDate(0, 0, 30)))
+
+	sa.SetAnnotations(map[string]string{PreviousAccessKeyAnnotationKey: "OLDKEY"})
+	assert.False(t, rotationDue(sa, now.AddDate(0, 0, 30)), "no rotation during an overlap period")
+}
+
+func TestPreviousAccessKeyExpired(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	sa := newRotatingServiceAccount(30)
+	assert.False(t, previousAccessKeyExpired(sa, now.Add(2*time.Hour)))
+
+	sa.SetAnnotations(map[string]string{
+		PreviousAccessKeyAnnotationKey:         "OLDKEY",
+		PreviousAccessKeyDeletionAnnotationKey: now.Add(time.Hour).Format(time.RFC3339),
+	})
+	assert.False(t, previousAccessKeyExpired(sa, now))
+	assert.True(t, previousAccessKeyExpired(sa, now.Add(time.Hour)))
+
+	updatePreviousAccessKeyStatus(sa)
+	assert.Equal(t, "OLDKEY", sa.Status.AtProvider.PreviousAccessKey)
+	assert.Equal(t, now.Add(time.Hour), sa.Status.AtProvider.PreviousAccessKeyDeletion.UTC())
+}
+
+func TestServiceAccountClient_deletePreviousServiceAccount(t *testing.T) {
+	tests := map[string]struct {
+		deleteStatus    int
+		wantErr         bool
+		wantAnnotations bool
+	}{
+		"GivenDeleteSucceeds_ThenAnnotationsRemoved": {
+			deleteStatus:    http.StatusNoContent,
+			wantAnnotations: false,
+		},
+		"GivenDeleteFails_ThenAnnotationsKept": {
+			deleteStatus:    http.StatusInternalServerError,
+			wantErr:         true,
+			wantAnnotations: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/minio/admin/v3/info-service-account":
+					data, err := json.Marshal(madmin.InfoServiceAccountResp{ParentUser: "alice", AccountStatus: "on"})
+					require.NoError(t, err)
+					encrypted, err := madmin.EncryptData("password", data)
+					require.NoError(t, err)
+					_, _ = w.Write(encrypted)
+				case "/minio/admin/v3/delete-service-account":
+					w.WriteHeader(tc.deleteStatus)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer srv.Close()
+			parsed, err := url.Parse(srv.URL)
+			require.NoError(t, err)
+			ma, err := madmin.New(parsed.Host, "admin", "password", false)
+			require.NoError(t, err)
+
+			sa := newRotatingServiceAccount(30)
+			sa.SetName("rotating")
+			sa.SetAnnotations(map[string]string{
+				PreviousAccessKeyAnnotationKey:         "OLDKEY",
+				PreviousAccessKeyDeletionAnnotationKey: time.Now().Format(time.RFC3339),
+			})
+			scheme := runtime.NewScheme()
+			require.NoError(t, miniov1beta1.SchemeBuilder.AddToScheme(scheme))
+			kube := fake.NewClientBuilder().WithScheme(scheme).WithObjects(sa).Build()
+			client := &serviceAccountClient{ma: ma, kube: kube, recorder: &eventRecorder{}}
+
+			err = client.deletePreviousServiceAccount(t.Context(), sa)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			stored := &miniov1beta1.ServiceAccount{}
+			require.NoError(t, kube.Get(t.Context(), types.NamespacedName{Name: "rotating"}, stored))
+			previousAccessKey, _ := getPreviousAccessKey(stored)
+			assert.Equal(t, tc.wantAnnotations, previousAccessKey != "")
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package serviceaccount
+
+import (
+	"context"
+	"fmt"
+
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// getDesiredSecretKey returns the secret key given by spec.forProvider.secretKeySecretRef or spec.forProvider.secretKey
+// together with the resource version of the Secret holding it, if any.
+// It returns an empty string if neither is set.
+func (s *serviceAccountClient) getDesiredSecretKey(ctx context.Context, serviceAccount *miniov1beta1.ServiceAccount) (string, string, error) {
+	ref := serviceAccount.Spec.ForProvider.SecretKeySecretRef
+	if ref == nil {
+		return serviceAccount.Spec.ForProvider.SecretKey, "", nil
+	}
+
+	secret := corev1.Secret{}
+	err := s.kube.Get(ctx, types.NamespacedName{Namespace: serviceAccount.GetNamespace(), Name: ref.Name}, &secret)
+	if err != nil {
+		return "", "", fmt.Errorf("cannot get secret key secret: %w", err)
+	}
+
+	secretKey, ok := secret.Data[ref.Key]
+	if !ok || len(secretKey) == 0 {
+		return "", "", fmt.Errorf("secret %q has no value for key %q", ref.Name, ref.Key)
+	}
+	return string(secretKey), secret.GetResourceVersion(), nil
+}
+
+// secretKeySecretChanged returns true if the Secret referenced by
+// spec.forProvider.secretKeySecretRef has changed since it was last applied.
+func (s *serviceAccountClient) secretKeySecretChanged(ctx context.Context, serviceAccount *miniov1beta1.ServiceAccount) (bool, error) {
+	if serviceAccount.Spec.ForProvider.SecretKeySecretRef == nil {
+		return false, nil
+	}
+
+	_, version, err := s.getDesiredSecretKey(ctx, serviceAccount)
+	if err != nil {
+		return false, err
+	}
+
+	return version != serviceAccount.Status.AtProvider.ObservedSecretKeyVersion, nil
+}
@@ -0,0 +1,63 @@
+package serviceaccount
+
+import (
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestServiceAccountClient_getDesiredSecretKey(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "sa-secret", Namespace: "production"},
+		Data:       map[string][]byte{"secretKey": []byte("from-secret")},
+	}
+	s := &serviceAccountClient{kube: fake.NewClientBuilder().WithObjects(secret).Build()}
+
+	sa := &miniov1beta1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-sa", Namespace: "production"},
+	}
+
+	secretKey, version, err := s.getDesiredSecretKey(t.Context(), sa)
+	require.NoError(t, err)
+	assert.Empty(t, secretKey, "neither secretKey nor secretKeySecretRef set")
+	assert.Empty(t, version)
+
+	sa.Spec.ForProvider.SecretKey = "plaintext"
+	secretKey, version, err = s.getDesiredSecretKey(t.Context(), sa)
+	require.NoError(t, err)
+	assert.Equal(t, "plaintext", secretKey)
+	assert.Empty(t, version)
+
+	changed, err := s.secretKeySecretChanged(t.Context(), sa)
+	require.NoError(t, err)
+	assert.False(t, changed, "a plaintext secret key has no Secret to track")
+
+	sa.Spec.ForProvider.SecretKey = ""
+	sa.Spec.ForProvider.SecretKeySecretRef = &xpv1.LocalSecretKeySelector{
+		LocalSecretReference: xpv1.LocalSecretReference{Name: "sa-secret"},
+		Key:                  "secretKey",
+	}
+	secretKey, version, err = s.getDesiredSecretKey(t.Context(), sa)
+	require.NoError(t, err)
+	assert.Equal(t, "from-secret", secretKey)
+	assert.NotEmpty(t, version)
+
+	changed, err = s.secretKeySecretChanged(t.Context(), sa)
+	require.NoError(t, err)
+	assert.True(t, changed, "a secret that was never applied counts as changed")
+
+	sa.Status.AtProvider.ObservedSecretKeyVersion = version
+	changed, err = s.secretKeySecretChanged(t.Context(), sa)
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	sa.Spec.ForProvider.SecretKeySecretRef.Key = "missing"
+	_, _, err = s.getDesiredSecretKey(t.Context(), sa)
+	assert.Error(t, err)
+}
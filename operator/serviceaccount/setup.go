@@ -10,6 +10,8 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
 	providerv1 "github.com/rossigee/provider-minio/apis/provider/v1"
+	"github.com/rossigee/provider-minio/internal/metrics"
+	"github.com/rossigee/provider-minio/internal/tracing"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -18,6 +20,10 @@ func SetupController(mgr ctrl.Manager) error {
 	name := strings.ToLower(miniov1beta1.ServiceAccountGroupKind)
 	recorder := event.NewAPIRecorder(mgr.GetEventRecorder(name))
 
+	if err := mgr.Add(metrics.NewStateRecorder(mgr, name, &miniov1beta1.ServiceAccountList{})); err != nil {
+		return err
+	}
+
 	return SetupControllerWithConnector(mgr, name, recorder, &connector{
 		kube:     mgr.GetClient(),
 		recorder: recorder,
@@ -38,7 +44,7 @@ func createReconciler(mgr ctrl.Manager, name string, recorder event.Recorder, c
 
 	return managed.NewReconciler(mgr,
 		resource.ManagedKind(miniov1beta1.ServiceAccountGroupVersionKind),
-		managed.WithExternalConnector(c),
+		managed.WithExternalConnector(tracing.NewExternalConnector(miniov1beta1.ServiceAccountKind, c)),
 		managed.WithLogger(logging.NewLogrLogger(mgr.GetLogger().WithValues("controller", name))),
 		managed.WithRecorder(recorder),
 		managed.WithPollInterval(1*time.Minute),
@@ -80,7 +86,7 @@ func createV1Beta1Reconciler(mgr ctrl.Manager, name string, recorder event.Recor
 
 	return managed.NewReconciler(mgr,
 		resource.ManagedKind(miniov1beta1.ServiceAccountGroupVersionKind),
-		managed.WithExternalConnector(c),
+		managed.WithExternalConnector(tracing.NewExternalConnector(miniov1beta1.ServiceAccountKind, c)),
 		managed.WithLogger(logging.NewLogrLogger(mgr.GetLogger().WithValues("controller", name))),
 		managed.WithRecorder(recorder),
 		managed.WithPollInterval(1*time.Minute),
@@ -2,14 +2,13 @@ package serviceaccount
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
-	"github.com/minio/madmin-go/v3"
 	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
@@ -29,43 +28,69 @@ func (s *serviceAccountClient) Update(ctx context.Context, mg resource.Managed)
 		return managed.ExternalUpdate{}, fmt.Errorf("service account has not been created yet (no external-name)")
 	}
 
-	// Prepare the update request
-	req := madmin.UpdateServiceAccountReq{}
+	now := time.Now()
+	if previousAccessKeyExpired(serviceAccount, now) {
+		err := s.deletePreviousServiceAccount(ctx, serviceAccount)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		return managed.ExternalUpdate{}, nil
+	}
 
-	// Update policy if specified
-	if serviceAccount.Spec.ForProvider.Policy != "" {
-		req.NewPolicy = json.RawMessage(serviceAccount.Spec.ForProvider.Policy)
+	if rotationDue(serviceAccount, now) {
+		connectionDetails, err := s.rotate(ctx, serviceAccount)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		return managed.ExternalUpdate{ConnectionDetails: connectionDetails}, nil
 	}
 
-	// Update name if specified
-	if serviceAccount.Spec.ForProvider.Name != "" {
-		req.NewName = serviceAccount.Spec.ForProvider.Name
+	info, err := s.ma.InfoServiceAccount(ctx, accessKey)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
 	}
 
-	// Update description if specified
-	if serviceAccount.Spec.ForProvider.Description != "" {
-		req.NewDescription = serviceAccount.Spec.ForProvider.Description
+	policy, err := s.desiredPolicy(ctx, serviceAccount)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
 	}
 
-	// Update expiration if specified
-	if serviceAccount.Spec.ForProvider.Expiration != nil {
-		req.NewExpiration = &serviceAccount.Spec.ForProvider.Expiration.Time
+	// Only send the fields that differ from MinIO
+	req, changed := serviceAccountChanges(serviceAccount, info, policy)
+
+	// The secret key cannot be observed, so it is always sent if specified
+	secretKey, secretKeyVersion, err := s.getDesiredSecretKey(ctx, serviceAccount)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if secretKey != "" {
+		req.NewSecretKey = secretKey
+		changed = true
 	}
 
-	// Update secret key if specified (typically not recommended in production)
-	if serviceAccount.Spec.ForProvider.SecretKey != "" {
-		req.NewSecretKey = serviceAccount.Spec.ForProvider.SecretKey
+	if !changed {
+		return managed.ExternalUpdate{}, nil
 	}
 
 	// Perform the update
-	err := s.ma.UpdateServiceAccount(ctx, accessKey, req)
+	err = s.ma.UpdateServiceAccount(ctx, accessKey, req)
 	if err != nil {
 		return managed.ExternalUpdate{}, err
 	}
 
 	s.emitUpdateEvent(serviceAccount)
 
-	return managed.ExternalUpdate{}, nil
+	if secretKey == "" {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	serviceAccount.Status.AtProvider.ObservedSecretKeyVersion = secretKeyVersion
+	connectionDetails := managed.ConnectionDetails{
+		AccessKeyName: []byte(accessKey),
+		SecretKeyName: []byte(secretKey),
+	}
+
+	return managed.ExternalUpdate{ConnectionDetails: connectionDetails}, nil
 }
 
 func (s *serviceAccountClient) emitUpdateEvent(serviceAccount *miniov1beta1.ServiceAccount) {
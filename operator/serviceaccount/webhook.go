@@ -37,6 +37,19 @@ func (v *Validator) ValidateCreate(ctx context.Context, serviceAccount *miniov1b
 		}
 	}
 
+	if err := validateRotation(serviceAccount); err != nil {
+		return nil, err
+	}
+
+	if err := validateTTL(serviceAccount); err != nil {
+		return nil, err
+	}
+
+	warnings, err := validateSecretKeySource(serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+
 	// Validate access key format if specified
 	if serviceAccount.Spec.ForProvider.AccessKey != "" {
 		if len(serviceAccount.Spec.ForProvider.AccessKey) < 3 || len(serviceAccount.Spec.ForProvider.AccessKey) > 128 {
@@ -51,7 +64,7 @@ func (v *Validator) ValidateCreate(ctx context.Context, serviceAccount *miniov1b
 		}
 	}
 
-	return nil, nil
+	return warnings, nil
 }
 
 // ValidateUpdate implements admission.Validator.
@@ -77,6 +90,19 @@ func (v *Validator) ValidateUpdate(ctx context.Context, oldServiceAccount, newSe
 		return nil, field.Invalid(field.NewPath("spec", "providerConfigRef", "name"), "null", "Provider config is required")
 	}
 
+	if err := validateRotation(newServiceAccount); err != nil {
+		return nil, err
+	}
+
+	if err := validateTTL(newServiceAccount); err != nil {
+		return nil, err
+	}
+
+	warnings, err := validateSecretKeySource(newServiceAccount)
+	if err != nil {
+		return nil, err
+	}
+
 	// Validate policy if specified
 	if newServiceAccount.Spec.ForProvider.Policy != "" {
 		err := v.validatePolicy(ctx, newServiceAccount, newServiceAccount.Spec.ForProvider.Policy)
@@ -85,7 +111,7 @@ func (v *Validator) ValidateUpdate(ctx context.Context, oldServiceAccount, newSe
 		}
 	}
 
-	return nil, nil
+	return warnings, nil
 }
 
 // ValidateDelete implements admission.Validator.
@@ -94,6 +120,50 @@ func (v *Validator) ValidateDelete(_ context.Context, _ *miniov1beta1.ServiceAcc
 	return nil, nil
 }
 
+func validateRotation(serviceAccount *miniov1beta1.ServiceAccount) error {
+	if serviceAccount.Spec.ForProvider.Rotation == nil {
+		return nil
+	}
+	// A rotation replaces the service account, so its keys cannot be fixed.
+	if serviceAccount.Spec.ForProvider.AccessKey != "" {
+		return field.Forbidden(field.NewPath("spec", "forProvider", "rotation"), "rotation cannot be combined with accessKey")
+	}
+	if serviceAccount.Spec.ForProvider.SecretKey != "" || serviceAccount.Spec.ForProvider.SecretKeySecretRef != nil {
+		return field.Forbidden(field.NewPath("spec", "forProvider", "rotation"), "rotation cannot be combined with secretKey")
+	}
+	return nil
+}
+
+func validateTTL(serviceAccount *miniov1beta1.ServiceAccount) error {
+	ttl := serviceAccount.Spec.ForProvider.TTL
+	if ttl == nil {
+		return nil
+	}
+	if ttl.Duration <= 0 {
+		return field.Invalid(field.NewPath("spec", "forProvider", "ttl"), ttl.Duration.String(), "ttl must be positive")
+	}
+	if serviceAccount.Spec.ForProvider.Expiration != nil {
+		return field.Forbidden(field.NewPath("spec", "forProvider", "ttl"), "ttl cannot be combined with expiration")
+	}
+	return nil
+}
+
+func validateSecretKeySource(serviceAccount *miniov1beta1.ServiceAccount) (admission.Warnings, error) {
+	params := serviceAccount.Spec.ForProvider
+	if params.SecretKeySecretRef != nil {
+		if params.SecretKey != "" {
+			return nil, field.Forbidden(field.NewPath("spec", "forProvider", "secretKeySecretRef"), "secretKeySecretRef cannot be combined with secretKey")
+		}
+		if params.AccessKey == "" {
+			return nil, field.Required(field.NewPath("spec", "forProvider", "accessKey"), "accessKey is required if secretKeySecretRef is set")
+		}
+	}
+	if params.SecretKey != "" {
+		return admission.Warnings{"spec.forProvider.secretKey is stored in plaintext, use spec.forProvider.secretKeySecretRef instead"}, nil
+	}
+	return nil, nil
+}
+
 func (v *Validator) validatePolicy(ctx context.Context, serviceAccount *miniov1beta1.ServiceAccount, policy string) error {
 	// Empty policy is valid (means inherit from parent user)
 	if policy == "" {
@@ -3,6 +3,7 @@ package serviceaccount
 import (
 	"context"
 	"testing"
+	"time"
 
 	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
 	"github.com/go-logr/logr/testr"
@@ -57,6 +58,68 @@ func TestValidator_ValidateCreate(t *testing.T) {
 			expectedError: true,
 			errorContains: "Provider config is required",
 		},
+		{
+			name: "Rotation with fixed access key - should fail",
+			serviceAccount: &miniov1beta1.ServiceAccount{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-serviceaccount",
+				},
+				Spec: miniov1beta1.ServiceAccountSpec{
+					ManagedResourceSpec: xpv1.ManagedResourceSpec{
+						ProviderConfigReference: &xpv1.ProviderConfigReference{
+							Name: "test-provider-config",
+						},
+					},
+					ForProvider: miniov1beta1.ServiceAccountParameters{
+						AccessKey: "myaccessid",
+						Rotation:  &miniov1beta1.ServiceAccountRotation{IntervalDays: 30},
+					},
+				},
+			},
+			expectedError: true,
+			errorContains: "rotation cannot be combined with accessKey",
+		},
+		{
+			name: "TTL with expiration - should fail",
+			serviceAccount: &miniov1beta1.ServiceAccount{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-serviceaccount",
+				},
+				Spec: miniov1beta1.ServiceAccountSpec{
+					ManagedResourceSpec: xpv1.ManagedResourceSpec{
+						ProviderConfigReference: &xpv1.ProviderConfigReference{
+							Name: "test-provider-config",
+						},
+					},
+					ForProvider: miniov1beta1.ServiceAccountParameters{
+						TTL:        &metav1.Duration{Duration: 720 * time.Hour},
+						Expiration: &metav1.Time{Time: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)},
+					},
+				},
+			},
+			expectedError: true,
+			errorContains: "ttl cannot be combined with expiration",
+		},
+		{
+			name: "Non-positive TTL - should fail",
+			serviceAccount: &miniov1beta1.ServiceAccount{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-serviceaccount",
+				},
+				Spec: miniov1beta1.ServiceAccountSpec{
+					ManagedResourceSpec: xpv1.ManagedResourceSpec{
+						ProviderConfigReference: &xpv1.ProviderConfigReference{
+							Name: "test-provider-config",
+						},
+					},
+					ForProvider: miniov1beta1.ServiceAccountParameters{
+						TTL: &metav1.Duration{Duration: -time.Hour},
+					},
+				},
+			},
+			expectedError: true,
+			errorContains: "ttl must be positive",
+		},
 		{
 			name: "Invalid access key - too short",
 			serviceAccount: &miniov1beta1.ServiceAccount{
@@ -140,6 +203,60 @@ func TestValidator_ValidateCreate(t *testing.T) {
 	}
 }
 
+func TestValidator_ValidateCreate_SecretKeySource(t *testing.T) {
+	newServiceAccount := func(params miniov1beta1.ServiceAccountParameters) *miniov1beta1.ServiceAccount {
+		return &miniov1beta1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-serviceaccount"},
+			Spec: miniov1beta1.ServiceAccountSpec{
+				ManagedResourceSpec: xpv1.ManagedResourceSpec{
+					ProviderConfigReference: &xpv1.ProviderConfigReference{Name: "test-provider-config"},
+				},
+				ForProvider: params,
+			},
+		}
+	}
+	ref := &xpv1.LocalSecretKeySelector{
+		LocalSecretReference: xpv1.LocalSecretReference{Name: "sa-secret"},
+		Key:                  "secretKey",
+	}
+	validator := &Validator{log: testr.New(t), kube: fake.NewClientBuilder().Build()}
+
+	t.Run("PlaintextSecretKey_ThenWarning", func(t *testing.T) {
+		warnings, err := validator.ValidateCreate(context.Background(), newServiceAccount(miniov1beta1.ServiceAccountParameters{
+			AccessKey: "myaccessid",
+			SecretKey: "myaccessdata",
+		}))
+		assert.NoError(t, err)
+		assert.Len(t, warnings, 1)
+		assert.Contains(t, warnings[0], "plaintext")
+	})
+
+	t.Run("SecretKeySecretRef_ThenNoWarning", func(t *testing.T) {
+		warnings, err := validator.ValidateCreate(context.Background(), newServiceAccount(miniov1beta1.ServiceAccountParameters{
+			AccessKey:          "myaccessid",
+			SecretKeySecretRef: ref,
+		}))
+		assert.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+
+	t.Run("SecretKeySecretRefWithoutAccessKey_ThenError", func(t *testing.T) {
+		_, err := validator.ValidateCreate(context.Background(), newServiceAccount(miniov1beta1.ServiceAccountParameters{
+			SecretKeySecretRef: ref,
+		}))
+		assert.ErrorContains(t, err, "accessKey is required")
+	})
+
+	t.Run("SecretKeySecretRefAndSecretKey_ThenError", func(t *testing.T) {
+		_, err := validator.ValidateCreate(context.Background(), newServiceAccount(miniov1beta1.ServiceAccountParameters{
+			AccessKey:          "myaccessid",
+			SecretKey:          "myaccessdata",
+			SecretKeySecretRef: ref,
+		}))
+		assert.ErrorContains(t, err, "cannot be combined with secretKey")
+	})
+}
+
 func TestValidator_ValidateUpdate(t *testing.T) {
 	tests := []struct {
 		name              string
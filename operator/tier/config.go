@@ -0,0 +1,164 @@
+package tier
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	accessKeyKey   = "accessKey"
+	secretKeyKey   = "secretKey"
+	accountNameKey = "accountName"
+	accountKeyKey  = "accountKey"
+	credentialsKey = "credentials"
+)
+
+// credentialKeys maps the tier types to the keys required in the credentials Secret.
+var credentialKeys = map[string][]string{
+	"s3":    {accessKeyKey, secretKeyKey},
+	"minio": {accessKeyKey, secretKeyKey},
+	"azure": {accountNameKey, accountKeyKey},
+	"gcs":   {credentialsKey},
+}
+
+// getCredentials returns the credentials of the remote storage together with the resource version of the Secret holding them.
+func (t *tierClient) getCredentials(ctx context.Context, tier *miniov1beta1.Tier) (map[string]string, string, error) {
+	ref := tier.Spec.ForProvider.CredentialsSecretRef
+
+	secret := corev1.Secret{}
+	err := t.kube.Get(ctx, types.NamespacedName{Namespace: tier.GetNamespace(), Name: ref.Name}, &secret)
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot get credentials secret: %w", err)
+	}
+
+	credentials := map[string]string{}
+	for _, key := range credentialKeys[tier.Spec.ForProvider.Type] {
+		value, ok := secret.Data[key]
+		if !ok || len(value) == 0 {
+			return nil, "", fmt.Errorf("secret %q has no value for key %q", ref.Name, key)
+		}
+		credentials[key] = string(value)
+	}
+
+	return credentials, secret.GetResourceVersion(), nil
+}
+
+// newTierConfig returns the configuration of the tier to be added to the server.
+func newTierConfig(tier *miniov1beta1.Tier, credentials map[string]string) (*madmin.TierConfig, error) {
+	params := tier.Spec.ForProvider
+	name := tier.GetTierName()
+
+	switch params.Type {
+	case "s3":
+		options := []madmin.S3Options{madmin.S3Prefix(params.Prefix), madmin.S3Region(params.Region)}
+		if params.Endpoint != "" {
+			options = append(options, madmin.S3Endpoint(params.Endpoint))
+		}
+		if params.StorageClass != "" {
+			options = append(options, madmin.S3StorageClass(params.StorageClass))
+		}
+		return madmin.NewTierS3(name, credentials[accessKeyKey], credentials[secretKeyKey], params.Bucket, options...)
+	case "azure":
+		options := []madmin.AzureOptions{madmin.AzurePrefix(params.Prefix), madmin.AzureRegion(params.Region)}
+		if params.Endpoint != "" {
+			options = append(options, madmin.AzureEndpoint(params.Endpoint))
+		}
+		if params.StorageClass != "" {
+			options = append(options, madmin.AzureStorageClass(params.StorageClass))
+		}
+		return madmin.NewTierAzure(name, credentials[accountNameKey], credentials[accountKeyKey], params.Bucket, options...)
+	case "gcs":
+		options := []madmin.GCSOptions{madmin.GCSPrefix(params.Prefix), madmin.GCSRegion(params.Region)}
+		if params.StorageClass != "" {
+			options = append(options, madmin.GCSStorageClass(params.StorageClass))
+		}
+		return madmin.NewTierGCS(name, []byte(credentials[credentialsKey]), params.Bucket, options...)
+	case "minio":
+		return madmin.NewTierMinIO(name, params.Endpoint, credentials[accessKeyKey], credentials[secretKeyKey], params.Bucket,
+			madmin.MinIOPrefix(params.Prefix), madmin.MinIORegion(params.Region))
+	}
+	return nil, fmt.Errorf("unsupported tier type %q", params.Type)
+}
+
+// newTierCreds returns the credentials to be updated on the server.
+// The account name of an Azure tier cannot be changed.
+func newTierCreds(tier *miniov1beta1.Tier, credentials map[string]string) madmin.TierCreds {
+	switch tier.Spec.ForProvider.Type {
+	case "azure":
+		return madmin.TierCreds{SecretKey: credentials[accountKeyKey]}
+	case "gcs":
+		return madmin.TierCreds{CredsJSON: []byte(credentials[credentialsKey])}
+	default:
+		return madmin.TierCreds{AccessKey: credentials[accessKeyKey], SecretKey: credentials[secretKeyKey]}
+	}
+}
+
+// findTier returns the tier with the name of the resource, or nil if it is not configured.
+func findTier(tier *miniov1beta1.Tier, tiers []*madmin.TierConfig) *madmin.TierConfig {
+	for _, candidate := range tiers {
+		if candidate != nil && strings.EqualFold(candidate.Name, tier.GetTierName()) {
+			return candidate
+		}
+	}
+	return nil
+}
+
+// configMismatch returns the settings in which the observed tier differs from the spec.
+// The credentials are not compared, the server does not return them.
+func configMismatch(tier *miniov1beta1.Tier, observed *madmin.TierConfig) ([]string, error) {
+	desired, err := newTierConfig(tier, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+	if desired.Type != observed.Type {
+		return []string{"type"}, nil
+	}
+
+	mismatch := []string{}
+	for _, setting := range []struct {
+		name              string
+		desired, observed string
+	}{
+		{"endpoint", desired.Endpoint(), observed.Endpoint()},
+		{"bucket", desired.Bucket(), observed.Bucket()},
+		{"prefix", desired.Prefix(), observed.Prefix()},
+		{"region", desired.Region(), observed.Region()},
+		{"storageClass", storageClass(desired), storageClass(observed)},
+	} {
+		if setting.desired != setting.observed {
+			mismatch = append(mismatch, setting.name)
+		}
+	}
+	return mismatch, nil
+}
+
+// storageClass returns the storage class of the tier on the remote storage, MinIO tiers have none.
+func storageClass(config *madmin.TierConfig) string {
+	switch config.Type {
+	case madmin.S3:
+		return config.S3.StorageClass
+	case madmin.Azure:
+		return config.Azure.StorageClass
+	case madmin.GCS:
+		return config.GCS.StorageClass
+	}
+	return ""
+}
+
+// updateStats sets the usage of the tier from the tier statistics of the server.
+func updateStats(tier *miniov1beta1.Tier, infos []madmin.TierInfo) {
+	for _, info := range infos {
+		if strings.EqualFold(info.Name, tier.GetTierName()) {
+			tier.Status.AtProvider.TotalSize = int64(info.Stats.TotalSize)
+			tier.Status.AtProvider.NumObjects = int64(info.Stats.NumObjects)
+			tier.Status.AtProvider.NumVersions = int64(info.Stats.NumVersions)
+			return
+		}
+	}
+}
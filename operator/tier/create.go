@@ -0,0 +1,50 @@
+package tier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (t *tierClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("creating resource")
+
+	tier, ok := mg.(*miniov1beta1.Tier)
+	if !ok {
+		return managed.ExternalCreation{}, errNotTier
+	}
+
+	tier.SetConditions(xpv1.Creating())
+
+	credentials, version, err := t.getCredentials(ctx, tier)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	config, err := newTierConfig(tier, credentials)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	err = t.ma.AddTier(ctx, config)
+	if err != nil {
+		return managed.ExternalCreation{}, fmt.Errorf("cannot add tier %q: %w", config.Name, err)
+	}
+
+	tier.Status.AtProvider.TierName = config.Name
+	tier.Status.AtProvider.ObservedSecretVersion = version
+
+	t.recorder.Event(tier, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Created",
+		Message: "Tier successfully created",
+	})
+	return managed.ExternalCreation{}, nil
+}
@@ -0,0 +1,37 @@
+package tier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// Delete removes the tier from the server. MinIO refuses to remove a tier that is still used by lifecycle rules.
+func (t *tierClient) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("deleting resource")
+
+	tier, ok := mg.(*miniov1beta1.Tier)
+	if !ok {
+		return managed.ExternalDelete{}, errNotTier
+	}
+
+	tier.SetConditions(xpv1.Deleting())
+	err := t.ma.RemoveTier(ctx, tier.GetTierName())
+	if err != nil {
+		return managed.ExternalDelete{}, fmt.Errorf("cannot remove tier %q: %w", tier.GetTierName(), err)
+	}
+
+	t.recorder.Event(tier, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Deleted",
+		Message: "Tier successfully deleted",
+	})
+	return managed.ExternalDelete{}, nil
+}
@@ -0,0 +1,7 @@
+package tier
+
+import "context"
+
+func (t *tierClient) Disconnect(ctx context.Context) error {
+	return nil
+}
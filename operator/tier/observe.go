@@ -0,0 +1,69 @@
+package tier
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (t *tierClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("observing resource")
+
+	tier, ok := mg.(*miniov1beta1.Tier)
+	if !ok {
+		return managed.ExternalObservation{}, errNotTier
+	}
+
+	tiers, err := t.ma.ListTiers(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, fmt.Errorf("cannot list tiers: %w", err)
+	}
+
+	observed := findTier(tier, tiers)
+	if observed == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// All settings but the credentials are immutable, a tier with the same name but a different
+	// configuration was not created by this resource and is not adopted.
+	mismatch, err := configMismatch(tier, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if len(mismatch) > 0 && tier.GetDeletionTimestamp() != nil {
+		// The tier is left in place when the resource is deleted.
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if len(mismatch) > 0 {
+		return managed.ExternalObservation{}, fmt.Errorf("tier %q exists on the server with a different %s", observed.Name, strings.Join(mismatch, ", "))
+	}
+
+	tier.Status.AtProvider.TierName = observed.Name
+
+	stats, err := t.ma.TierStats(ctx)
+	if err != nil {
+		log.V(1).Info("cannot get tier stats", "error", err.Error())
+	} else {
+		updateStats(tier, stats)
+	}
+
+	// Credentials are not returned by the server, changes are detected by the resource version of the Secret.
+	_, version, err := t.getCredentials(ctx, tier)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if version != tier.Status.AtProvider.ObservedSecretVersion {
+		tier.SetConditions(miniov1beta1.Updating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	tier.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
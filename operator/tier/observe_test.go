@@ -0,0 +1,168 @@
+package tier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTier(params miniov1beta1.TierParameters) *miniov1beta1.Tier {
+	if params.CredentialsSecretRef.Name == "" {
+		params.CredentialsSecretRef = xpv1.LocalSecretReference{Name: "tier-credentials"}
+	}
+	return &miniov1beta1.Tier{
+		ObjectMeta: metav1.ObjectMeta{Name: "cold", Namespace: "default"},
+		Spec: miniov1beta1.TierSpec{
+			ManagedResourceSpec: xpv1.ManagedResourceSpec{ProviderConfigReference: &xpv1.ProviderConfigReference{Name: "minio"}},
+			ForProvider:         params,
+		},
+	}
+}
+
+func newKube(t *testing.T, data map[string]string) client.Client {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tier-credentials", Namespace: "default"},
+		Data:       map[string][]byte{},
+	}
+	for key, value := range data {
+		secret.Data[key] = []byte(value)
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+}
+
+func newAdminClient(t *testing.T, tiers []*madmin.TierConfig, stats []madmin.TierInfo) *madmin.AdminClient {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/minio/admin/v3/tier":
+			_ = json.NewEncoder(w).Encode(tiers)
+		case "/minio/admin/v3/tier-stats":
+			_ = json.NewEncoder(w).Encode(stats)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	parsed, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	ma, err := madmin.New(parsed.Host, "admin", "password", false)
+	require.NoError(t, err)
+	return ma
+}
+
+func TestObserve(t *testing.T) {
+	kube := newKube(t, map[string]string{"accessKey": "access", "secretKey": "secret"})
+	secret := &corev1.Secret{}
+	require.NoError(t, kube.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "tier-credentials"}, secret))
+
+	configured, err := madmin.NewTierMinIO("COLD", "https://cold.example.com", "access", "secret", "archive")
+	require.NoError(t, err)
+	stats := []madmin.TierInfo{{Name: "COLD", Type: "minio", Stats: madmin.TierStats{TotalSize: 2048, NumObjects: 3, NumVersions: 4}}}
+
+	otherBucket, err := madmin.NewTierMinIO("COLD", "https://cold.example.com", "access", "secret", "other", madmin.MinIOPrefix("cold/"))
+	require.NoError(t, err)
+	otherType, err := madmin.NewTierS3("COLD", "access", "secret", "archive")
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		tiers            []*madmin.TierConfig
+		observedVersion  string
+		expectedExists   bool
+		expectedUpToDate bool
+		expectedErr      string
+	}{
+		"NotFound": {},
+		"UpToDate": {
+			tiers:            []*madmin.TierConfig{configured},
+			observedVersion:  secret.GetResourceVersion(),
+			expectedExists:   true,
+			expectedUpToDate: true,
+		},
+		"CredentialsChanged": {
+			tiers:           []*madmin.TierConfig{configured},
+			observedVersion: "1",
+			expectedExists:  true,
+		},
+		"DifferentBucketAndPrefix": {
+			tiers:       []*madmin.TierConfig{otherBucket},
+			expectedErr: `tier "COLD" exists on the server with a different bucket, prefix`,
+		},
+		"DifferentType": {
+			tiers:       []*madmin.TierConfig{otherType},
+			expectedErr: `tier "COLD" exists on the server with a different type`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tierClient := &tierClient{ma: newAdminClient(t, tc.tiers, stats), kube: kube}
+			tier := newTier(miniov1beta1.TierParameters{Type: "minio", Endpoint: "https://cold.example.com", Bucket: "archive"})
+			tier.Status.AtProvider.ObservedSecretVersion = tc.observedVersion
+
+			observation, err := tierClient.Observe(context.Background(), tier)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedExists, observation.ResourceExists)
+			assert.Equal(t, tc.expectedUpToDate, observation.ResourceUpToDate)
+			if tc.expectedExists {
+				assert.Equal(t, "COLD", tier.Status.AtProvider.TierName)
+				assert.Equal(t, int64(2048), tier.Status.AtProvider.TotalSize)
+				assert.Equal(t, int64(3), tier.Status.AtProvider.NumObjects)
+				assert.Equal(t, int64(4), tier.Status.AtProvider.NumVersions)
+			}
+		})
+	}
+}
+
+func TestNewTierConfig(t *testing.T) {
+	s3Tier := newTier(miniov1beta1.TierParameters{Type: "s3", Bucket: "archive", Prefix: "cold/", Region: "eu-west-1", StorageClass: "GLACIER"})
+	config, err := newTierConfig(s3Tier, map[string]string{"accessKey": "access", "secretKey": "secret"})
+	require.NoError(t, err)
+	assert.Equal(t, "COLD", config.Name)
+	assert.Equal(t, madmin.S3, config.Type)
+	assert.Equal(t, "https://s3.amazonaws.com", config.S3.Endpoint)
+	assert.Equal(t, "archive", config.S3.Bucket)
+	assert.Equal(t, "cold/", config.S3.Prefix)
+	assert.Equal(t, "eu-west-1", config.S3.Region)
+	assert.Equal(t, "GLACIER", config.S3.StorageClass)
+	assert.Equal(t, "secret", config.S3.SecretKey)
+
+	azureTier := newTier(miniov1beta1.TierParameters{Type: "azure", TierName: "AZURE", Bucket: "container"})
+	config, err = newTierConfig(azureTier, map[string]string{"accountName": "account", "accountKey": "key"})
+	require.NoError(t, err)
+	assert.Equal(t, "AZURE", config.Name)
+	assert.Equal(t, "account", config.Azure.AccountName)
+	assert.Equal(t, madmin.TierCreds{SecretKey: "key"}, newTierCreds(azureTier, map[string]string{"accountName": "account", "accountKey": "key"}))
+
+	gcsTier := newTier(miniov1beta1.TierParameters{Type: "gcs", Bucket: "archive"})
+	config, err = newTierConfig(gcsTier, map[string]string{"credentials": `{"type":"service_account"}`})
+	require.NoError(t, err)
+	credentials, err := config.GCS.GetCredentialJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"service_account"}`, string(credentials))
+}
+
+func TestGetCredentials_MissingKey(t *testing.T) {
+	tierClient := &tierClient{kube: newKube(t, map[string]string{"accessKey": "access"})}
+	_, _, err := tierClient.getCredentials(context.Background(), newTier(miniov1beta1.TierParameters{Type: "s3", Bucket: "archive"}))
+	assert.ErrorContains(t, err, `no value for key "secretKey"`)
+}
@@ -0,0 +1,42 @@
+package tier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// Update updates the credentials of the tier, the only part of a tier that can be changed.
+func (t *tierClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("updating resource")
+
+	tier, ok := mg.(*miniov1beta1.Tier)
+	if !ok {
+		return managed.ExternalUpdate{}, errNotTier
+	}
+
+	credentials, version, err := t.getCredentials(ctx, tier)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	err = t.ma.EditTier(ctx, tier.GetTierName(), newTierCreds(tier, credentials))
+	if err != nil {
+		return managed.ExternalUpdate{}, fmt.Errorf("cannot update credentials of tier %q: %w", tier.GetTierName(), err)
+	}
+
+	tier.Status.AtProvider.ObservedSecretVersion = version
+
+	t.recorder.Event(tier, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Updated",
+		Message: "Tier credentials successfully updated",
+	})
+	return managed.ExternalUpdate{}, nil
+}
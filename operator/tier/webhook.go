@@ -0,0 +1,101 @@
+package tier
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/go-logr/logr"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ admission.Validator[*miniov1beta1.Tier] = &Validator{}
+
+// tierNamePattern matches the tier names accepted by MinIO, which are upper case.
+var tierNamePattern = regexp.MustCompile(`^[A-Z0-9_-]+$`)
+
+// Validator validates admission requests.
+type Validator struct {
+	log logr.Logger
+}
+
+// ValidateCreate implements admission.Validator.
+func (v *Validator) ValidateCreate(_ context.Context, tier *miniov1beta1.Tier) (admission.Warnings, error) {
+	v.log.V(1).Info("Validate create")
+	return nil, validateTier(tier)
+}
+
+// ValidateUpdate implements admission.Validator.
+func (v *Validator) ValidateUpdate(_ context.Context, oldTier, newTier *miniov1beta1.Tier) (admission.Warnings, error) {
+	v.log.V(1).Info("Validate update")
+
+	if newTier.GetDeletionTimestamp() != nil {
+		return nil, nil
+	}
+
+	// Only the credentials of a tier can be changed on the server
+	path := field.NewPath("spec", "forProvider")
+	oldParams, newParams := oldTier.Spec.ForProvider, newTier.Spec.ForProvider
+	for _, immutable := range []struct {
+		name     string
+		old, new string
+	}{
+		{"tierName", oldTier.GetTierName(), newTier.GetTierName()},
+		{"type", oldParams.Type, newParams.Type},
+		{"endpoint", oldParams.Endpoint, newParams.Endpoint},
+		{"bucket", oldParams.Bucket, newParams.Bucket},
+		{"prefix", oldParams.Prefix, newParams.Prefix},
+		{"region", oldParams.Region, newParams.Region},
+		{"storageClass", oldParams.StorageClass, newParams.StorageClass},
+	} {
+		if immutable.old != immutable.new {
+			return nil, field.Invalid(path.Child(immutable.name), immutable.new, "Changing the "+immutable.name+" of a tier is not allowed")
+		}
+	}
+
+	return nil, validateTier(newTier)
+}
+
+// ValidateDelete implements admission.Validator.
+func (v *Validator) ValidateDelete(_ context.Context, _ *miniov1beta1.Tier) (admission.Warnings, error) {
+	v.log.V(1).Info("validate delete (noop)")
+	return nil, nil
+}
+
+func validateTier(tier *miniov1beta1.Tier) error {
+	providerConfigRef := tier.Spec.ProviderConfigReference
+	if providerConfigRef == nil || providerConfigRef.Name == "" {
+		return field.Invalid(field.NewPath("spec", "providerConfigRef", "name"), "null", "Provider config is required")
+	}
+
+	params := tier.Spec.ForProvider
+	path := field.NewPath("spec", "forProvider")
+	if _, ok := credentialKeys[params.Type]; !ok {
+		return field.NotSupported(path.Child("type"), params.Type, []string{"s3", "azure", "gcs", "minio"})
+	}
+	if !tierNamePattern.MatchString(tier.GetTierName()) {
+		return field.Invalid(path.Child("tierName"), tier.GetTierName(), "Tier name must only contain upper case letters, digits, '-' and '_'")
+	}
+	if params.Bucket == "" {
+		return field.Required(path.Child("bucket"), "Bucket is required")
+	}
+	if params.CredentialsSecretRef.Name == "" {
+		return field.Required(path.Child("credentialsSecretRef", "name"), "Credentials secret is required")
+	}
+
+	switch params.Type {
+	case "minio":
+		if params.Endpoint == "" {
+			return field.Required(path.Child("endpoint"), "Endpoint is required for minio tiers")
+		}
+		if params.StorageClass != "" {
+			return field.Forbidden(path.Child("storageClass"), "Storage class is not supported by minio tiers")
+		}
+	case "gcs":
+		if params.Endpoint != "" {
+			return field.Forbidden(path.Child("endpoint"), "Custom endpoints are not supported by gcs tiers")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package tier
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTier(t *testing.T) {
+	tests := map[string]struct {
+		params  miniov1beta1.TierParameters
+		wantErr string
+	}{
+		"S3": {
+			params: miniov1beta1.TierParameters{Type: "s3", Bucket: "archive", StorageClass: "GLACIER"},
+		},
+		"MinIO": {
+			params: miniov1beta1.TierParameters{Type: "minio", Endpoint: "https://cold.example.com", Bucket: "archive"},
+		},
+		"LowerCaseTierName": {
+			params:  miniov1beta1.TierParameters{TierName: "cold", Type: "s3", Bucket: "archive"},
+			wantErr: "upper case",
+		},
+		"MissingBucket": {
+			params:  miniov1beta1.TierParameters{Type: "s3"},
+			wantErr: "Bucket is required",
+		},
+		"MinIOWithoutEndpoint": {
+			params:  miniov1beta1.TierParameters{Type: "minio", Bucket: "archive"},
+			wantErr: "Endpoint is required",
+		},
+		"MinIOWithStorageClass": {
+			params:  miniov1beta1.TierParameters{Type: "minio", Endpoint: "https://cold.example.com", Bucket: "archive", StorageClass: "STANDARD"},
+			wantErr: "not supported by minio tiers",
+		},
+		"GCSWithEndpoint": {
+			params:  miniov1beta1.TierParameters{Type: "gcs", Endpoint: "https://storage.example.com", Bucket: "archive"},
+			wantErr: "not supported by gcs tiers",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateTier(newTier(tc.params))
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestValidateUpdate_Immutable(t *testing.T) {
+	v := &Validator{log: logr.Discard()}
+	oldTier := newTier(miniov1beta1.TierParameters{Type: "s3", Bucket: "archive"})
+
+	newTier := oldTier.DeepCopy()
+	newTier.Spec.ForProvider.CredentialsSecretRef.Name = "rotated"
+	_, err := v.ValidateUpdate(context.Background(), oldTier, newTier)
+	assert.NoError(t, err)
+
+	newTier = oldTier.DeepCopy()
+	newTier.Spec.ForProvider.Bucket = "other"
+	_, err = v.ValidateUpdate(context.Background(), oldTier, newTier)
+	assert.ErrorContains(t, err, "Changing the bucket of a tier is not allowed")
+}
@@ -3,7 +3,6 @@ package user
 import (
 	"context"
 	"fmt"
-	"net/url"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
@@ -27,11 +26,13 @@ type connector struct {
 }
 
 type userClient struct {
-	ma          *madmin.AdminClient
-	kube        client.Client
-	recorder    event.Recorder
-	url         *url.URL
-	tlsSettings bool
+	ma       *madmin.AdminClient
+	kube     client.Client
+	recorder event.Recorder
+
+	// credentialsInvalid is set by Observe if the user's credentials have been
+	// changed outside of the provider, so that Update replaces them.
+	credentialsInvalid bool
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -53,22 +54,15 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, err
 	}
 
-	ma, err := minioutil.NewMinioAdmin(ctx, c.kube, config)
-	if err != nil {
-		return nil, err
-	}
-
-	parsed, err := url.Parse(config.Spec.MinioURL)
+	ma, err := minioutil.NewMinioAdmin(ctx, c.kube, config, miniov1beta1.UserKind)
 	if err != nil {
 		return nil, err
 	}
 
 	uc := &userClient{
-		ma:          ma,
-		kube:        c.kube,
-		recorder:    c.recorder,
-		url:         parsed,
-		tlsSettings: minioutil.IsTLSEnabled(parsed),
+		ma:       ma,
+		kube:     c.kube,
+		recorder: c.recorder,
 	}
 
 	return uc, nil
@@ -9,7 +9,6 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/minio/madmin-go/v3"
 	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
-	"github.com/sethvargo/go-password/password"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -29,7 +28,13 @@ func (u *userClient) Create(ctx context.Context, mg resource.Managed) (managed.E
 		return managed.ExternalCreation{}, errNotUser
 	}
 
-	secretKey, err := password.Generate(64, 5, 0, false, true)
+	var secretKey, secretKeyVersion string
+	var err error
+	if user.Spec.ForProvider.SecretKeySecretRef != nil {
+		secretKey, secretKeyVersion, err = u.getReferencedSecretKey(ctx, user)
+	} else {
+		secretKey, err = generateSecretKey()
+	}
 	if err != nil {
 		return managed.ExternalCreation{}, err
 	}
@@ -53,6 +58,15 @@ func (u *userClient) Create(ctx context.Context, mg resource.Managed) (managed.E
 		return managed.ExternalCreation{}, err
 	}
 
+	if accountStatus := madmin.AccountStatus(user.GetAccountStatus()); accountStatus != madmin.AccountEnabled {
+		err = u.ma.SetUserStatus(ctx, user.GetUserName(), accountStatus)
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+
+	user.Status.AtProvider.ObservedSecretKeyVersion = secretKeyVersion
+
 	u.emitCreationEvent(user)
 
 	annotations := user.GetAnnotations()
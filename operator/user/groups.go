@@ -0,0 +1,66 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/rossigee/provider-minio/operator/minioutil"
+)
+
+// equalGroups returns true if the user's group memberships match the desired groups.
+// Users without desired groups don't have their memberships managed.
+func (u *userClient) equalGroups(memberOf []string, user *miniov1beta1.User) bool {
+	if user.Spec.ForProvider.Groups == nil {
+		return true
+	}
+	add, remove := minioutil.DiffSets(memberOf, *user.Spec.ForProvider.Groups)
+	return len(add) == 0 && len(remove) == 0
+}
+
+// reconcileUserGroups adds the user to missing groups and removes it from the groups that are not desired anymore.
+func (u *userClient) reconcileUserGroups(ctx context.Context, user *miniov1beta1.User, memberOf []string) error {
+	if user.Spec.ForProvider.Groups == nil {
+		return nil
+	}
+
+	add, remove := minioutil.DiffSets(memberOf, *user.Spec.ForProvider.Groups)
+
+	if len(add) > 0 && !user.Spec.ForProvider.CreateGroups {
+		// Adding a member to a group that doesn't exist creates the group.
+		groups, err := u.ma.ListGroups(ctx)
+		if err != nil {
+			return err
+		}
+		for _, group := range add {
+			if !slices.Contains(groups, group) {
+				return fmt.Errorf("group %q does not exist and createGroups is not enabled", group)
+			}
+		}
+	}
+
+	for _, group := range add {
+		err := u.ma.UpdateGroupMembers(ctx, madmin.GroupAddRemove{
+			Group:   group,
+			Members: []string{user.GetUserName()},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, group := range remove {
+		err := u.ma.UpdateGroupMembers(ctx, madmin.GroupAddRemove{
+			Group:    group,
+			Members:  []string{user.GetUserName()},
+			IsRemove: true,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package user
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// newGroupsTestClient returns a userClient backed by a fake MinIO admin API that knows the given groups
+// and records the group membership updates it receives.
+func newGroupsTestClient(t *testing.T, groups []string) (*userClient, *[]madmin.GroupAddRemove) {
+	updates := &[]madmin.GroupAddRemove{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/minio/admin/v3/groups":
+			_ = json.NewEncoder(w).Encode(groups)
+		case "/minio/admin/v3/update-group-members":
+			body, _ := io.ReadAll(r.Body)
+			update := madmin.GroupAddRemove{}
+			require.NoError(t, json.Unmarshal(body, &update))
+			*updates = append(*updates, update)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	parsed, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	ma, err := madmin.New(parsed.Host, "admin", "password", false)
+	require.NoError(t, err)
+
+	return &userClient{ma: ma}, updates
+}
+
+func newGroupsTestUser(groups []string, createGroups bool) *miniov1beta1.User {
+	user := &miniov1beta1.User{
+		ObjectMeta: metav1.ObjectMeta{Name: "alice"},
+		Spec: miniov1beta1.UserSpec{
+			ForProvider: miniov1beta1.UserParameters{CreateGroups: createGroups},
+		},
+	}
+	if groups != nil {
+		user.Spec.ForProvider.Groups = &groups
+	}
+	return user
+}
+
+func TestUserClient_reconcileUserGroups(t *testing.T) {
+	t.Run("GivenGroupsUnset_ThenMembershipUntouched", func(t *testing.T) {
+		u, updates := newGroupsTestClient(t, nil)
+		require.NoError(t, u.reconcileUserGroups(t.Context(), newGroupsTestUser(nil, false), []string{"devs"}))
+		assert.Empty(t, *updates)
+	})
+
+	t.Run("GivenMembershipDelta_ThenOnlyDeltaUpdated", func(t *testing.T) {
+		u, updates := newGroupsTestClient(t, []string{"devs", "ops", "admins"})
+		user := newGroupsTestUser([]string{"devs", "ops"}, false)
+
+		require.NoError(t, u.reconcileUserGroups(t.Context(), user, []string{"devs", "admins"}))
+		assert.Equal(t, []madmin.GroupAddRemove{
+			{Group: "ops", Members: []string{"alice"}},
+			{Group: "admins", Members: []string{"alice"}, IsRemove: true},
+		}, *updates)
+	})
+
+	t.Run("GivenMissingGroupWithoutCreateGroups_ThenError", func(t *testing.T) {
+		u, updates := newGroupsTestClient(t, []string{"devs"})
+		err := u.reconcileUserGroups(t.Context(), newGroupsTestUser([]string{"new"}, false), nil)
+		assert.ErrorContains(t, err, `group "new" does not exist`)
+		assert.Empty(t, *updates)
+	})
+
+	t.Run("GivenMissingGroupWithCreateGroups_ThenAdded", func(t *testing.T) {
+		u, updates := newGroupsTestClient(t, nil)
+		require.NoError(t, u.reconcileUserGroups(t.Context(), newGroupsTestUser([]string{"new"}, true), nil))
+		assert.Equal(t, []madmin.GroupAddRemove{{Group: "new", Members: []string{"alice"}}}, *updates)
+	})
+
+	t.Run("GivenEmptyGroups_ThenRemovedFromAll", func(t *testing.T) {
+		u, updates := newGroupsTestClient(t, nil)
+		require.NoError(t, u.reconcileUserGroups(t.Context(), newGroupsTestUser([]string{}, false), []string{"devs"}))
+		assert.Equal(t, []madmin.GroupAddRemove{{Group: "devs", Members: []string{"alice"}, IsRemove: true}}, *updates)
+	})
+}
+
+func TestUserClient_equalGroups(t *testing.T) {
+	u := &userClient{}
+	assert.True(t, u.equalGroups([]string{"devs"}, newGroupsTestUser(nil, false)))
+	assert.True(t, u.equalGroups([]string{"ops", "devs"}, newGroupsTestUser([]string{"devs", "ops"}, false)))
+	assert.False(t, u.equalGroups([]string{"devs"}, newGroupsTestUser([]string{}, false)))
+	assert.False(t, u.equalGroups(nil, newGroupsTestUser([]string{"devs"}, false)))
+}
+
+func TestUserParameters_GroupsJSONRoundTrip(t *testing.T) {
+	tests := map[string]struct {
+		givenJSON  string
+		wantGroups *[]string
+	}{
+		"GivenGroupsUnset_ThenNil": {
+			givenJSON:  `{}`,
+			wantGroups: nil,
+		},
+		"GivenEmptyGroups_ThenEmptyList": {
+			givenJSON:  `{"groups":[]}`,
+			wantGroups: &[]string{},
+		},
+		"GivenGroups_ThenList": {
+			givenJSON:  `{"groups":["devs","ops"]}`,
+			wantGroups: &[]string{"devs", "ops"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := miniov1beta1.UserParameters{}
+			require.NoError(t, json.Unmarshal([]byte(tc.givenJSON), &params))
+
+			data, err := json.Marshal(params)
+			require.NoError(t, err)
+			roundTripped := miniov1beta1.UserParameters{}
+			require.NoError(t, json.Unmarshal(data, &roundTripped))
+
+			assert.Equal(t, tc.wantGroups, roundTripped.Groups)
+			assert.Equal(t, tc.wantGroups == nil, (&userClient{}).equalGroups([]string{"devs"}, &miniov1beta1.User{Spec: miniov1beta1.UserSpec{ForProvider: roundTripped}}))
+		})
+	}
+}
@@ -2,18 +2,15 @@ package user
 
 import (
 	"context"
-	"reflect"
-	"strings"
+	"time"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
 	"github.com/minio/madmin-go/v3"
-	"github.com/minio/minio-go/v7"
-	"github.com/minio/minio-go/v7/pkg/credentials"
 	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/types"
+	"github.com/rossigee/provider-minio/operator/minioutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -56,8 +53,41 @@ func (u *userClient) Observe(ctx context.Context, mg resource.Managed) (managed.
 		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
 	}
 
+	// Group memberships are only reported by GetUserInfo.
+	userInfo, err := u.ma.GetUserInfo(ctx, user.GetUserName())
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	user.Status.AtProvider.Groups = userInfo.MemberOf
+
+	if !u.equalGroups(userInfo.MemberOf, user) {
+		user.SetConditions(miniov1beta1.Updating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	if minioUser.Status != madmin.AccountStatus(user.GetAccountStatus()) {
+		user.Status.AtProvider.Status = string(minioUser.Status)
+		user.SetConditions(miniov1beta1.Updating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	initRotationStatus(user, time.Now())
+	if secretRotationDue(user, time.Now()) {
+		user.SetConditions(miniov1beta1.Updating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	changed, err := u.referencedSecretKeyChanged(ctx, user)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if changed {
+		user.SetConditions(miniov1beta1.Updating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
 	user.Status.AtProvider.Status = string(minioUser.Status)
-	user.Status.AtProvider.Policies = minioUser.PolicyName
+	user.Status.AtProvider.Policies = minioutil.ParsePolicies(minioUser.PolicyName)
 
 	if minioUser.Status == madmin.AccountEnabled {
 		user.SetConditions(xpv1.Available())
@@ -65,33 +95,18 @@ func (u *userClient) Observe(ctx context.Context, mg resource.Managed) (managed.
 		user.SetConditions(miniov1beta1.Disabled())
 	}
 
-	if mg.GetDeletionTimestamp() == nil {
-
-		secret := corev1.Secret{}
-
-		err = u.kube.Get(ctx, types.NamespacedName{
-			Namespace: mg.GetNamespace(),
-			Name:      mg.(resource.ModernManaged).GetWriteConnectionSecretToReference().Name,
-		}, &secret)
-		if err != nil {
-			return managed.ExternalObservation{}, err
+	if mg.GetDeletionTimestamp() == nil && credentialVerificationDue(user, time.Now()) {
+		if credentialsChanged(user, minioUser) {
+			log.Info("credentials have been changed outside of the provider, replacing them")
+			u.credentialsInvalid = true
+			user.SetConditions(miniov1beta1.Updating())
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
 		}
-
-		mclient, err := minio.New(u.url.Host, &minio.Options{
-			Creds:  credentials.NewStaticV4(string(secret.Data[AccessKeyName]), string(secret.Data[SecretKeyName]), ""),
-			Secure: u.tlsSettings,
-		})
-		if err != nil {
-			return managed.ExternalObservation{ResourceUpToDate: false, ResourceExists: true}, nil
-		}
-
-		_, err = mclient.ListBuckets(context.Background())
-		// AccessDenied is ok in this context, because we just want to check if the user has working credentials
-		if err != nil && err.Error() != "Access Denied." {
-			return managed.ExternalObservation{ResourceUpToDate: false, ResourceExists: true}, nil
-		}
-
-		log.Info("user client created, everything went fine " + string(secret.Data[AccessKeyName]) + " " + string(secret.Data[SecretKeyName]))
+		now := metav1.Now()
+		user.Status.AtProvider.LastCredentialVerification = &now
+	}
+	if user.Spec.ForProvider.CredentialVerification != nil {
+		recordCredentialsUpdatedAt(user, minioUser)
 	}
 
 	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
@@ -99,13 +114,6 @@ func (u *userClient) Observe(ctx context.Context, mg resource.Managed) (managed.
 
 func (u *userClient) equalPolicies(minioUser madmin.UserInfo, user *miniov1beta1.User) bool {
 	// policyName contains a string with all applied policies separated by comma
-	minioPolicies := strings.Split(minioUser.PolicyName, ",")
-
-	// if policyName is an empty string, then string.Split() will create an array with just one empty string
-	// to make it comparable we need to catch this case and set it to an empty array
-	if minioPolicies[0] == "" {
-		minioPolicies = nil
-	}
-
-	return reflect.DeepEqual(minioPolicies, user.Spec.ForProvider.Policies)
+	attach, detach := minioutil.DiffSets(minioutil.ParsePolicies(minioUser.PolicyName), user.Spec.ForProvider.Policies)
+	return len(attach) == 0 && len(detach) == 0
 }
@@ -0,0 +1,29 @@
+package user
+
+import (
+	"context"
+
+	"github.com/minio/madmin-go/v3"
+	"github.com/rossigee/provider-minio/operator/minioutil"
+)
+
+// reconcileUserPolicies attaches and detaches only the policies that differ between current and desired.
+// New policies are attached before obsolete ones are detached, so the user never loses access it keeps.
+func (u *userClient) reconcileUserPolicies(ctx context.Context, userName string, current, desired []string) error {
+	attach, detach := minioutil.DiffSets(current, desired)
+
+	err := u.setUserPolicies(ctx, userName, attach)
+	if err != nil {
+		return err
+	}
+
+	if len(detach) == 0 {
+		return nil
+	}
+
+	_, err = u.ma.DetachPolicy(ctx, madmin.PolicyAssociationReq{
+		Policies: detach,
+		User:     userName,
+	})
+	return err
+}
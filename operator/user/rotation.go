@@ -0,0 +1,95 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/sethvargo/go-password/password"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// RotateAtAnnotationKey is the annotation that triggers a secret key rotation whenever its value changes.
+	RotateAtAnnotationKey string = "minio.crossplane.io/rotate-at"
+)
+
+// generateSecretKey returns a new random secret key for a user.
+func generateSecretKey() (string, error) {
+	return password.Generate(64, 5, 0, false, true)
+}
+
+// initRotationStatus starts tracking the secret key age of users that were
+// created before rotation was tracked or whose status has been lost.
+func initRotationStatus(user *miniov1beta1.User, now time.Time) {
+	if user.Status.AtProvider.LastSecretRotation != nil {
+		return
+	}
+	t := metav1.NewTime(now)
+	user.Status.AtProvider.LastSecretRotation = &t
+	user.Status.AtProvider.ObservedRotateAt = user.GetAnnotations()[RotateAtAnnotationKey]
+}
+
+// secretRotationDue returns true if the rotate-at annotation has been set to a
+// new value or if the secret key is older than the configured interval.
+func secretRotationDue(user *miniov1beta1.User, now time.Time) bool {
+	status := user.Status.AtProvider
+	if status.LastSecretRotation == nil || user.Spec.ForProvider.SecretKeySecretRef != nil {
+		// Referenced secret keys are rotated by updating the Secret.
+		return false
+	}
+
+	if at := user.GetAnnotations()[RotateAtAnnotationKey]; at != "" && at != status.ObservedRotateAt {
+		return true
+	}
+
+	rotation := user.Spec.ForProvider.SecretRotation
+	if rotation == nil || rotation.IntervalDays <= 0 {
+		return false
+	}
+	maxAge := time.Duration(rotation.IntervalDays) * 24 * time.Hour
+	return now.Sub(status.LastSecretRotation.Time) >= maxAge
+}
+
+// rotateSecretKey sets a new secret key on the user, publishes it to the connection secret
+// and returns it as connection details.
+func (u *userClient) rotateSecretKey(ctx context.Context, user *miniov1beta1.User, status madmin.AccountStatus) (managed.ConnectionDetails, error) {
+	secretKey, err := generateSecretKey()
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.ma.SetUser(ctx, user.GetUserName(), secretKey, status)
+	if err != nil {
+		return nil, err
+	}
+
+	connectionDetails := managed.ConnectionDetails{
+		AccessKeyName: []byte(user.GetUserName()),
+		SecretKeyName: []byte(secretKey),
+	}
+	// The new key only exists in memory until it is published. The reconciler
+	// saves the status even if publishing fails, so the rotation is only
+	// recorded once the key has been written, otherwise it would not be retried.
+	_, err = managed.NewAPILocalSecretPublisher(u.kube, u.kube.Scheme()).PublishConnection(ctx, user, connectionDetails)
+	if err != nil {
+		return nil, err
+	}
+
+	now := metav1.Now()
+	user.Status.AtProvider.LastSecretRotation = &now
+	// Observe records the time MinIO reports for this change.
+	user.Status.AtProvider.CredentialsUpdatedAt = nil
+	user.Status.AtProvider.ObservedRotateAt = user.GetAnnotations()[RotateAtAnnotationKey]
+
+	u.recorder.Event(user, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "SecretRotated",
+		Message: "User secret key successfully rotated",
+	})
+
+	return connectionDetails, nil
+}
@@ -0,0 +1,162 @@
+package user
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_secretRotationDue(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	rotatedAt := func(t time.Time) *metav1.Time {
+		mt := metav1.NewTime(t)
+		return &mt
+	}
+
+	tests := map[string]struct {
+		annotations map[string]string
+		rotation    *miniov1beta1.SecretRotation
+		status      miniov1beta1.UserProviderStatus
+		want        bool
+	}{
+		"GivenNoRotationStatus_ThenFalse": {
+			annotations: map[string]string{RotateAtAnnotationKey: "now"},
+			rotation:    &miniov1beta1.SecretRotation{IntervalDays: 1},
+			want:        false,
+		},
+		"GivenNoPolicy_ThenFalse": {
+			status: miniov1beta1.UserProviderStatus{LastSecretRotation: rotatedAt(now.AddDate(-1, 0, 0))},
+			want:   false,
+		},
+		"GivenSecretYoungerThanInterval_ThenFalse": {
+			rotation: &miniov1beta1.SecretRotation{IntervalDays: 90},
+			status:   miniov1beta1.UserProviderStatus{LastSecretRotation: rotatedAt(now.AddDate(0, 0, -89))},
+			want:     false,
+		},
+		"GivenSecretOlderThanInterval_ThenTrue": {
+			rotation: &miniov1beta1.SecretRotation{IntervalDays: 90},
+			status:   miniov1beta1.UserProviderStatus{LastSecretRotation: rotatedAt(now.AddDate(0, 0, -90))},
+			want:     true,
+		},
+		"GivenNewRotateAtAnnotation_ThenTrue": {
+			annotations: map[string]string{RotateAtAnnotationKey: "2025-06-01"},
+			status: miniov1beta1.UserProviderStatus{
+				LastSecretRotation: rotatedAt(now),
+				ObservedRotateAt:   "2025-01-01",
+			},
+			want: true,
+		},
+		"GivenObservedRotateAtAnnotation_ThenFalse": {
+			annotations: map[string]string{RotateAtAnnotationKey: "2025-01-01"},
+			status: miniov1beta1.UserProviderStatus{
+				LastSecretRotation: rotatedAt(now),
+				ObservedRotateAt:   "2025-01-01",
+			},
+			want: false,
+		},
+		"GivenRemovedRotateAtAnnotation_ThenFalse": {
+			status: miniov1beta1.UserProviderStatus{
+				LastSecretRotation: rotatedAt(now),
+				ObservedRotateAt:   "2025-01-01",
+			},
+			want: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			user := &miniov1beta1.User{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations},
+				Spec: miniov1beta1.UserSpec{
+					ForProvider: miniov1beta1.UserParameters{SecretRotation: tc.rotation},
+				},
+				Status: miniov1beta1.UserStatus{AtProvider: tc.status},
+			}
+			assert.Equal(t, tc.want, secretRotationDue(user, now))
+		})
+	}
+}
+
+func Test_initRotationStatus(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	user := &miniov1beta1.User{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{RotateAtAnnotationKey: "initial"}},
+	}
+
+	initRotationStatus(user, now)
+
+	assert.Equal(t, now, user.Status.AtProvider.LastSecretRotation.Time)
+	assert.Equal(t, "initial", user.Status.AtProvider.ObservedRotateAt)
+	assert.False(t, secretRotationDue(user, now), "an annotation present at creation must not trigger a rotation")
+
+	initRotationStatus(user, now.Add(time.Hour))
+	assert.Equal(t, now, user.Status.AtProvider.LastSecretRotation.Time, "existing status must not be reset")
+}
+
+func TestUserClient_rotateSecretKey(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/minio/admin/v3/add-user" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	parsed, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	ma, err := madmin.New(parsed.Host, "admin", "password", false)
+	require.NoError(t, err)
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, miniov1beta1.SchemeBuilder.AddToScheme(scheme))
+
+	newUser := func() *miniov1beta1.User {
+		return &miniov1beta1.User{
+			ObjectMeta: metav1.ObjectMeta{Name: "alice", Namespace: "default", UID: "uid"},
+			Spec: miniov1beta1.UserSpec{
+				ManagedResourceSpec: xpv1.ManagedResourceSpec{
+					WriteConnectionSecretToReference: &xpv1.LocalSecretReference{Name: "alice-credentials"},
+				},
+			},
+		}
+	}
+
+	t.Run("GivenPublished_ThenRotationRecorded", func(t *testing.T) {
+		kube := fake.NewClientBuilder().WithScheme(scheme).Build()
+		u := &userClient{ma: ma, kube: kube, recorder: event.NewNopRecorder()}
+		user := newUser()
+
+		details, err := u.rotateSecretKey(t.Context(), user, madmin.AccountEnabled)
+		require.NoError(t, err)
+
+		secret := &corev1.Secret{}
+		require.NoError(t, kube.Get(t.Context(), types.NamespacedName{Namespace: "default", Name: "alice-credentials"}, secret))
+		assert.Equal(t, details[SecretKeyName], secret.Data[SecretKeyName])
+		assert.NotNil(t, user.Status.AtProvider.LastSecretRotation)
+	})
+
+	t.Run("GivenPublishFails_ThenRotationNotRecorded", func(t *testing.T) {
+		// A Secret that is not controlled by the user cannot be overwritten.
+		kube := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "alice-credentials", Namespace: "default"},
+		}).Build()
+		u := &userClient{ma: ma, kube: kube, recorder: event.NewNopRecorder()}
+		user := newUser()
+
+		_, err := u.rotateSecretKey(t.Context(), user, madmin.AccountEnabled)
+		assert.Error(t, err)
+		assert.Nil(t, user.Status.AtProvider.LastSecretRotation)
+	})
+}
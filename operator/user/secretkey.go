@@ -0,0 +1,68 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// getReferencedSecretKey returns the secret key referenced by spec.forProvider.secretKeySecretRef
+// together with the resource version of the Secret holding it.
+func (u *userClient) getReferencedSecretKey(ctx context.Context, user *miniov1beta1.User) (string, string, error) {
+	ref := user.Spec.ForProvider.SecretKeySecretRef
+
+	secret := corev1.Secret{}
+	err := u.kube.Get(ctx, types.NamespacedName{Namespace: user.GetNamespace(), Name: ref.Name}, &secret)
+	if err != nil {
+		return "", "", fmt.Errorf("cannot get secret key secret: %w", err)
+	}
+
+	secretKey, ok := secret.Data[ref.Key]
+	if !ok || len(secretKey) == 0 {
+		return "", "", fmt.Errorf("secret %q has no value for key %q", ref.Name, ref.Key)
+	}
+
+	return string(secretKey), secret.GetResourceVersion(), nil
+}
+
+// referencedSecretKeyChanged returns true if the Secret referenced by
+// spec.forProvider.secretKeySecretRef has changed since it was last applied.
+func (u *userClient) referencedSecretKeyChanged(ctx context.Context, user *miniov1beta1.User) (bool, error) {
+	if user.Spec.ForProvider.SecretKeySecretRef == nil {
+		return false, nil
+	}
+
+	_, version, err := u.getReferencedSecretKey(ctx, user)
+	if err != nil {
+		return false, err
+	}
+
+	return version != user.Status.AtProvider.ObservedSecretKeyVersion, nil
+}
+
+// applyReferencedSecretKey sets the referenced secret key on the user and
+// returns it as connection details.
+func (u *userClient) applyReferencedSecretKey(ctx context.Context, user *miniov1beta1.User, status madmin.AccountStatus) (managed.ConnectionDetails, error) {
+	secretKey, version, err := u.getReferencedSecretKey(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.ma.SetUser(ctx, user.GetUserName(), secretKey, status)
+	if err != nil {
+		return nil, err
+	}
+
+	user.Status.AtProvider.ObservedSecretKeyVersion = version
+	user.Status.AtProvider.CredentialsUpdatedAt = nil
+
+	return managed.ConnectionDetails{
+		AccessKeyName: []byte(user.GetUserName()),
+		SecretKeyName: []byte(secretKey),
+	}, nil
+}
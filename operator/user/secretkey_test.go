@@ -0,0 +1,50 @@
+package user
+
+import (
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestUserClient_referencedSecretKeyChanged(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "migrated-creds", Namespace: "team-a"},
+		Data:       map[string][]byte{"secretKey": []byte("existing-secret")},
+	}
+	u := &userClient{kube: fake.NewClientBuilder().WithObjects(secret).Build()}
+
+	user := &miniov1beta1.User{
+		ObjectMeta: metav1.ObjectMeta{Name: "migrated", Namespace: "team-a"},
+		Spec: miniov1beta1.UserSpec{
+			ForProvider: miniov1beta1.UserParameters{
+				SecretKeySecretRef: &xpv1.LocalSecretKeySelector{
+					LocalSecretReference: xpv1.LocalSecretReference{Name: "migrated-creds"},
+					Key:                  "secretKey",
+				},
+			},
+		},
+	}
+
+	secretKey, version, err := u.getReferencedSecretKey(t.Context(), user)
+	require.NoError(t, err)
+	assert.Equal(t, "existing-secret", secretKey)
+
+	changed, err := u.referencedSecretKeyChanged(t.Context(), user)
+	require.NoError(t, err)
+	assert.True(t, changed, "a secret that was never applied counts as changed")
+
+	user.Status.AtProvider.ObservedSecretKeyVersion = version
+	changed, err = u.referencedSecretKeyChanged(t.Context(), user)
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	user.Spec.ForProvider.SecretKeySecretRef.Key = "missing"
+	_, _, err = u.getReferencedSecretKey(t.Context(), user)
+	assert.Error(t, err)
+}
@@ -2,15 +2,14 @@ package user
 
 import (
 	"context"
-	"strings"
+	"time"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/minio/madmin-go/v3"
 	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/types"
+	"github.com/rossigee/provider-minio/operator/minioutil"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -28,44 +27,63 @@ func (u *userClient) Update(ctx context.Context, mg resource.Managed) (managed.E
 		return managed.ExternalUpdate{}, err
 	}
 
-	policies := strings.Split(userInfo.PolicyName, ",")
-	// empty string will just result in an array with one empty string, so let's check that
-	if policies[0] == "" {
-		policies = []string{}
+	err = u.reconcileUserPolicies(ctx, user.GetUserName(), minioutil.ParsePolicies(userInfo.PolicyName), user.Spec.ForProvider.Policies)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	err = u.reconcileUserGroups(ctx, user, userInfo.MemberOf)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
 	}
 
-	if len(policies) > 0 {
-		req := madmin.PolicyAssociationReq{
-			Policies: policies,
-			User:     user.GetUserName(),
+	// Only change the account status, the secret key is left untouched.
+	accountStatus := madmin.AccountStatus(user.GetAccountStatus())
+	if userInfo.Status != accountStatus {
+		err = u.ma.SetUserStatus(ctx, user.GetUserName(), accountStatus)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
 		}
-		_, err = u.ma.DetachPolicy(ctx, req)
+		user.Status.AtProvider.CredentialsUpdatedAt = nil
+		u.emitUpdateEvent(user)
+		return managed.ExternalUpdate{}, nil
+	}
+
+	if secretRotationDue(user, time.Now()) {
+		connectionDetails, err := u.rotateSecretKey(ctx, user, accountStatus)
 		if err != nil {
 			return managed.ExternalUpdate{}, err
 		}
+		u.emitUpdateEvent(user)
+		return managed.ExternalUpdate{ConnectionDetails: connectionDetails}, nil
 	}
 
-	err = u.setUserPolicies(ctx, user.GetUserName(), user.Spec.ForProvider.Policies)
+	secretKeyChanged, err := u.referencedSecretKeyChanged(ctx, user)
 	if err != nil {
 		return managed.ExternalUpdate{}, err
 	}
-
-	if mg.GetDeletionTimestamp() == nil {
-
-		secret := corev1.Secret{}
-
-		err = u.kube.Get(ctx, types.NamespacedName{
-			Namespace: mg.GetNamespace(),
-			Name:      mg.(resource.ModernManaged).GetWriteConnectionSecretToReference().Name,
-		}, &secret)
+	if mg.GetDeletionTimestamp() == nil && secretKeyChanged {
+		connectionDetails, err := u.applyReferencedSecretKey(ctx, user, accountStatus)
 		if err != nil {
 			return managed.ExternalUpdate{}, err
 		}
+		u.emitUpdateEvent(user)
+		return managed.ExternalUpdate{ConnectionDetails: connectionDetails}, nil
+	}
 
-		err = u.ma.SetUser(ctx, string(secret.Data[AccessKeyName]), string(secret.Data[SecretKeyName]), madmin.AccountEnabled)
+	if mg.GetDeletionTimestamp() == nil && u.credentialsInvalid {
+		// The published secret key cannot be read back, so it is replaced instead of re-applied.
+		var connectionDetails managed.ConnectionDetails
+		if user.Spec.ForProvider.SecretKeySecretRef != nil {
+			connectionDetails, err = u.applyReferencedSecretKey(ctx, user, accountStatus)
+		} else {
+			connectionDetails, err = u.rotateSecretKey(ctx, user, accountStatus)
+		}
 		if err != nil {
 			return managed.ExternalUpdate{}, err
 		}
+		u.emitUpdateEvent(user)
+		return managed.ExternalUpdate{ConnectionDetails: connectionDetails}, nil
 	}
 
 	u.emitUpdateEvent(user)
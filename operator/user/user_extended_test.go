@@ -139,7 +139,7 @@ func TestUserClient_equalPolicies_EdgeCases(t *testing.T) {
 					},
 				},
 			},
-			expectEqual: false, // reflect.DeepEqual([]string{""}, []string{}) = false
+			expectEqual: true, // no policies on either side
 		},
 		{
 			name: "Single policy match",
@@ -170,7 +170,7 @@ func TestUserClient_equalPolicies_EdgeCases(t *testing.T) {
 			expectEqual: true,
 		},
 		{
-			name: "Different order should not match",
+			name: "Different order should match",
 			minioUser: madmin.UserInfo{
 				PolicyName: "write-access,read-only",
 			},
@@ -181,7 +181,7 @@ func TestUserClient_equalPolicies_EdgeCases(t *testing.T) {
 					},
 				},
 			},
-			expectEqual: false,
+			expectEqual: true,
 		},
 		{
 			name: "Policy count mismatch",
@@ -0,0 +1,55 @@
+package user
+
+import (
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	defaultCredentialVerificationInterval = 1 * time.Hour
+)
+
+// credentialVerificationDue returns true if credential verification is enabled
+// and the last successful verification is older than the configured interval.
+func credentialVerificationDue(user *miniov1beta1.User, now time.Time) bool {
+	verification := user.Spec.ForProvider.CredentialVerification
+	if verification == nil || user.GetWriteConnectionSecretToReference() == nil {
+		return false
+	}
+
+	last := user.Status.AtProvider.LastCredentialVerification
+	if last == nil {
+		return true
+	}
+
+	interval := verification.Interval.Duration
+	if interval <= 0 {
+		interval = defaultCredentialVerificationInterval
+	}
+	return now.Sub(last.Time) >= interval
+}
+
+// credentialsChanged returns true if MinIO reports a different time for the last change
+// of the user than the one recorded after the provider last set its credentials.
+// The secret key itself cannot be compared, MinIO does not return it.
+func credentialsChanged(user *miniov1beta1.User, minioUser madmin.UserInfo) bool {
+	recorded := user.Status.AtProvider.CredentialsUpdatedAt
+	if recorded == nil {
+		return false
+	}
+	// The recorded time only has a precision of seconds.
+	return !minioUser.UpdatedAt.Truncate(time.Second).Equal(recorded.Time)
+}
+
+// recordCredentialsUpdatedAt records the time MinIO reports as the last change of the user,
+// unless it has already been recorded since the provider last changed the user.
+func recordCredentialsUpdatedAt(user *miniov1beta1.User, minioUser madmin.UserInfo) {
+	if user.Status.AtProvider.CredentialsUpdatedAt != nil || minioUser.UpdatedAt.IsZero() {
+		return
+	}
+	t := metav1.NewTime(minioUser.UpdatedAt.Truncate(time.Second))
+	user.Status.AtProvider.CredentialsUpdatedAt = &t
+}
@@ -0,0 +1,119 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane/apis/v2/core/v2"
+	"github.com/minio/madmin-go/v3"
+	miniov1beta1 "github.com/rossigee/provider-minio/apis/minio/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_credentialVerificationDue(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	verifiedAt := func(t time.Time) *metav1.Time {
+		mt := metav1.NewTime(t)
+		return &mt
+	}
+	connectionSecret := &xpv1.LocalSecretReference{Name: "creds"}
+
+	tests := map[string]struct {
+		params     miniov1beta1.UserParameters
+		secretRef  *xpv1.LocalSecretReference
+		lastVerify *metav1.Time
+		want       bool
+	}{
+		"GivenVerificationDisabled_ThenFalse": {
+			secretRef: connectionSecret,
+			want:      false,
+		},
+		"GivenNoConnectionSecret_ThenFalse": {
+			params: miniov1beta1.UserParameters{CredentialVerification: &miniov1beta1.CredentialVerification{}},
+			want:   false,
+		},
+		"GivenNeverVerified_ThenTrue": {
+			params:    miniov1beta1.UserParameters{CredentialVerification: &miniov1beta1.CredentialVerification{}},
+			secretRef: connectionSecret,
+			want:      true,
+		},
+		"GivenVerifiedWithinDefaultInterval_ThenFalse": {
+			params:     miniov1beta1.UserParameters{CredentialVerification: &miniov1beta1.CredentialVerification{}},
+			secretRef:  connectionSecret,
+			lastVerify: verifiedAt(now.Add(-59 * time.Minute)),
+			want:       false,
+		},
+		"GivenVerifiedBeforeInterval_ThenTrue": {
+			params: miniov1beta1.UserParameters{CredentialVerification: &miniov1beta1.CredentialVerification{
+				Interval: metav1.Duration{Duration: 10 * time.Minute},
+			}},
+			secretRef:  connectionSecret,
+			lastVerify: verifiedAt(now.Add(-10 * time.Minute)),
+			want:       true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			user := &miniov1beta1.User{
+				Spec: miniov1beta1.UserSpec{ForProvider: tc.params},
+				Status: miniov1beta1.UserStatus{
+					AtProvider: miniov1beta1.UserProviderStatus{LastCredentialVerification: tc.lastVerify},
+				},
+			}
+			user.SetWriteConnectionSecretToReference(tc.secretRef)
+			assert.Equal(t, tc.want, credentialVerificationDue(user, now))
+		})
+	}
+}
+
+func Test_credentialsChanged(t *testing.T) {
+	updatedAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	recorded := metav1.NewTime(updatedAt)
+
+	tests := map[string]struct {
+		recorded  *metav1.Time
+		updatedAt time.Time
+		want      bool
+	}{
+		"GivenNothingRecorded_ThenFalse": {
+			updatedAt: updatedAt,
+			want:      false,
+		},
+		"GivenSameTimeWithSubSecondPrecision_ThenFalse": {
+			recorded:  &recorded,
+			updatedAt: updatedAt.Add(500 * time.Millisecond),
+			want:      false,
+		},
+		"GivenUserChangedSinceRecorded_ThenTrue": {
+			recorded:  &recorded,
+			updatedAt: updatedAt.Add(time.Minute),
+			want:      true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			user := &miniov1beta1.User{
+				Status: miniov1beta1.UserStatus{
+					AtProvider: miniov1beta1.UserProviderStatus{CredentialsUpdatedAt: tc.recorded},
+				},
+			}
+			assert.Equal(t, tc.want, credentialsChanged(user, madmin.UserInfo{UpdatedAt: tc.updatedAt}))
+		})
+	}
+}
+
+func Test_recordCredentialsUpdatedAt(t *testing.T) {
+	updatedAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	user := &miniov1beta1.User{}
+
+	recordCredentialsUpdatedAt(user, madmin.UserInfo{})
+	assert.Nil(t, user.Status.AtProvider.CredentialsUpdatedAt, "servers without update times must not be recorded")
+
+	recordCredentialsUpdatedAt(user, madmin.UserInfo{UpdatedAt: updatedAt.Add(500 * time.Millisecond)})
+	assert.Equal(t, updatedAt, user.Status.AtProvider.CredentialsUpdatedAt.Time)
+
+	recordCredentialsUpdatedAt(user, madmin.UserInfo{UpdatedAt: updatedAt.Add(time.Hour)})
+	assert.Equal(t, updatedAt, user.Status.AtProvider.CredentialsUpdatedAt.Time, "a recorded time must only be replaced after the provider changed the user")
+	assert.True(t, credentialsChanged(user, madmin.UserInfo{UpdatedAt: updatedAt.Add(time.Hour)}))
+}
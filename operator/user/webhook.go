@@ -39,6 +39,10 @@ func (v *Validator) ValidateCreate(ctx context.Context, user *miniov1beta1.User)
 		return nil, field.Invalid(field.NewPath("spec", "providerConfigRef", "name"), "null", "Provider config is required")
 	}
 
+	if err := validateSecretKeySource(user); err != nil {
+		return nil, err
+	}
+
 	if err := v.doesPolicyExist(ctx, user); err != nil {
 		return nil, field.Invalid(field.NewPath("spec", "forProvider", "policies"), user.Spec.ForProvider.Policies, err.Error())
 	}
@@ -63,6 +67,10 @@ func (v *Validator) ValidateUpdate(ctx context.Context, oldUser, newUser *miniov
 		return nil, nil
 	}
 
+	if err := validateSecretKeySource(newUser); err != nil {
+		return nil, err
+	}
+
 	if err := v.doesPolicyExist(ctx, newUser); err != nil {
 		return nil, field.Invalid(field.NewPath("spec", "forProvider", "policies"), newUser.Spec.ForProvider.Policies, err.Error())
 	}
@@ -76,6 +84,18 @@ func (v *Validator) ValidateDelete(_ context.Context, _ *miniov1beta1.User) (adm
 	return nil, nil
 }
 
+func validateSecretKeySource(user *miniov1beta1.User) error {
+	if user.Spec.ForProvider.SecretKeySecretRef != nil && user.Spec.ForProvider.SecretRotation != nil {
+		return field.Forbidden(field.NewPath("spec", "forProvider", "secretRotation"), "secretRotation cannot be combined with secretKeySecretRef")
+	}
+	ref, connectionSecret := user.Spec.ForProvider.SecretKeySecretRef, user.GetWriteConnectionSecretToReference()
+	if ref != nil && connectionSecret != nil && ref.Name == connectionSecret.Name {
+		// Publishing the connection secret would update the referenced Secret and trigger another update.
+		return field.Invalid(field.NewPath("spec", "forProvider", "secretKeySecretRef", "name"), ref.Name, "secretKeySecretRef must not reference the connection secret")
+	}
+	return nil
+}
+
 func (v *Validator) doesPolicyExist(ctx context.Context, user *miniov1beta1.User) error {
 	if len(user.Spec.ForProvider.Policies) == 0 {
 		return nil
@@ -113,5 +133,5 @@ func getProviderConfig(ctx context.Context, user *miniov1beta1.User, kube client
 }
 
 func getMinioAdmin(ctx context.Context, kube client.Client, config *providerv1.ProviderConfig) (cannedPolicyLister, error) {
-	return minioutil.NewMinioAdmin(ctx, kube, config)
+	return minioutil.NewMinioAdmin(ctx, kube, config, miniov1beta1.UserKind)
 }
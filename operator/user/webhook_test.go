@@ -40,6 +40,46 @@ func TestValidator_ValidateCreate(t *testing.T) {
 			wantErr: true,
 			obj:     &miniov1beta1.User{},
 		},
+		{
+			name:    "GivenSecretKeyRefAndRotation_ThenError",
+			wantErr: true,
+			obj: &miniov1beta1.User{
+				Spec: miniov1beta1.UserSpec{
+					ForProvider: miniov1beta1.UserParameters{
+						SecretKeySecretRef: &xpv1.LocalSecretKeySelector{
+							LocalSecretReference: xpv1.LocalSecretReference{Name: "creds"},
+							Key:                  "secretKey",
+						},
+						SecretRotation: &miniov1beta1.SecretRotation{IntervalDays: 90},
+					},
+					ManagedResourceSpec: xpv1.ManagedResourceSpec{
+						ProviderConfigReference: &xpv1.ProviderConfigReference{
+							Name: "test",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "GivenSecretKeyRefToConnectionSecret_ThenError",
+			wantErr: true,
+			obj: &miniov1beta1.User{
+				Spec: miniov1beta1.UserSpec{
+					ForProvider: miniov1beta1.UserParameters{
+						SecretKeySecretRef: &xpv1.LocalSecretKeySelector{
+							LocalSecretReference: xpv1.LocalSecretReference{Name: "creds"},
+							Key:                  "secretKey",
+						},
+					},
+					ManagedResourceSpec: xpv1.ManagedResourceSpec{
+						ProviderConfigReference: &xpv1.ProviderConfigReference{
+							Name: "test",
+						},
+						WriteConnectionSecretToReference: &xpv1.LocalSecretReference{Name: "creds"},
+					},
+				},
+			},
+		},
 		{
 			name:    "GivenNotExistingPolicies_ThenError",
 			wantErr: true,